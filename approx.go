@@ -0,0 +1,340 @@
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package set
+
+import (
+	"fmt"
+	"math"
+)
+
+// ApproxSet is a collection of floats where the elements which are within
+// epsilon of each other are equal: an element is not added if a member is
+// within epsilon of it, and Has and Remove find the members within epsilon
+// of their argument. NaN is a single member as in FloatSet.
+//
+// The elements are bucketed by their quotient by epsilon, so the members
+// within epsilon of a value are found in the buckets of the value and its
+// two neighbours, in O(1) for evenly spread elements.
+//
+// Equality within epsilon is not transitive: with epsilon 1, adding 0, 0.8
+// and 1.6 keeps 0 and 1.6, and the result depends on the order the elements
+// are added in. For the same reason, the set operations are not symmetric:
+// Union, Difference, Intersection and SymmetricDifference of a and b keep
+// the epsilon of a, add the members of a first, and judge whether an element
+// is in b within the epsilon of b.
+// The zero value is an empty ApproxSet ready to use, whose epsilon is 0: its
+// elements are equal only if they are ==, as in a FloatSet.
+type ApproxSet[T Float] struct {
+	epsilon T
+	buckets map[int64][]T
+	n       int
+	nan     bool
+}
+
+// ApproxFloat32 is a float32 collection where the elements within epsilon
+// of each other are equal.
+type ApproxFloat32 = ApproxSet[float32]
+
+// ApproxFloat64 is a float64 collection where the elements within epsilon
+// of each other are equal.
+type ApproxFloat64 = ApproxSet[float64]
+
+// NewApproxSet initializes a new ApproxSet with the tolerance epsilon, it
+// panics if epsilon is not a positive finite number.
+func NewApproxSet[T Float](epsilon T, elements ...T) *ApproxSet[T] {
+	if !(epsilon > 0) || math.IsInf(float64(epsilon), 1) {
+		panic(fmt.Sprintf("set: invalid epsilon %v", epsilon))
+	}
+	a := &ApproxSet[T]{epsilon: epsilon, buckets: map[int64][]T{}}
+	a.Add(elements...)
+	return a
+}
+
+// NewApproxFloat32 initializes a new ApproxFloat32 with the tolerance epsilon.
+func NewApproxFloat32(epsilon float32, elements ...float32) *ApproxFloat32 {
+	return NewApproxSet(epsilon, elements...)
+}
+
+// NewApproxFloat64 initializes a new ApproxFloat64 with the tolerance epsilon.
+func NewApproxFloat64(epsilon float64, elements ...float64) *ApproxFloat64 {
+	return NewApproxSet(epsilon, elements...)
+}
+
+// maxBucket bounds the buckets, the values whose quotient by epsilon is
+// beyond it, including the infinities, share the outermost buckets.
+const maxBucket = 1 << 62
+
+// Epsilon returns the tolerance of ApproxSet.
+func (a *ApproxSet[T]) Epsilon() T {
+	return a.epsilon
+}
+
+// bucket returns the bucket of element, which is not NaN.
+func (a *ApproxSet[T]) bucket(element T) int64 {
+	if a.epsilon == 0 {
+		// the equal elements are the same number, -0 aside
+		if element == 0 {
+			element = 0
+		}
+		return int64(math.Float64bits(float64(element)))
+	}
+	q := math.Floor(float64(element) / float64(a.epsilon))
+	switch {
+	case q > maxBucket:
+		return maxBucket
+	case q < -maxBucket:
+		return -maxBucket
+	default:
+		return int64(q)
+	}
+}
+
+// near reports whether x and y are equal within epsilon.
+func (a *ApproxSet[T]) near(x, y T) bool {
+	return x == y || math.Abs(float64(x)-float64(y)) <= float64(a.epsilon)
+}
+
+// find returns the bucket and the index of a member within epsilon of
+// element, which is not NaN.
+func (a *ApproxSet[T]) find(element T) (int64, int, bool) {
+	b := a.bucket(element)
+	for _, k := range [...]int64{b, b - 1, b + 1} {
+		for i, member := range a.buckets[k] {
+			if a.near(member, element) {
+				return k, i, true
+			}
+		}
+	}
+	return 0, 0, false
+}
+
+// Add adds the elements to ApproxSet, if no member is within epsilon of it.
+func (a *ApproxSet[T]) Add(elements ...T) {
+	for _, element := range elements {
+		if element != element {
+			if !a.nan {
+				a.nan = true
+				a.n++
+			}
+			continue
+		}
+		if _, _, ok := a.find(element); ok {
+			continue
+		}
+		if a.buckets == nil {
+			a.buckets = map[int64][]T{}
+		}
+		b := a.bucket(element)
+		a.buckets[b] = append(a.buckets[b], element)
+		a.n++
+	}
+}
+
+// Remove removes the members within epsilon of the elements from ApproxSet,
+// there may be one on each side of an element.
+func (a *ApproxSet[T]) Remove(elements ...T) {
+	for _, element := range elements {
+		if element != element {
+			if a.nan {
+				a.nan = false
+				a.n--
+			}
+			continue
+		}
+		for {
+			b, i, ok := a.find(element)
+			if !ok {
+				break
+			}
+			v := a.buckets[b]
+			v[i] = v[len(v)-1]
+			if v = v[:len(v)-1]; len(v) == 0 {
+				delete(a.buckets, b)
+			} else {
+				a.buckets[b] = v
+			}
+			a.n--
+		}
+	}
+}
+
+// Size returns the number of elements in ApproxSet.
+func (a *ApproxSet[T]) Size() int {
+	return a.n
+}
+
+// IsEmpty returns whether the ApproxSet is Empty.
+func (a *ApproxSet[T]) IsEmpty() bool {
+	return a.n == 0
+}
+
+// Clear removes all items from the ApproxSet.
+func (a *ApproxSet[T]) Clear() {
+	a.buckets, a.n, a.nan = map[int64][]T{}, 0, false
+}
+
+// Has judges whether a member within epsilon of the specified element
+// exists in the ApproxSet.
+func (a *ApproxSet[T]) Has(element T) bool {
+	if element != element {
+		return a.nan
+	}
+	_, _, ok := a.find(element)
+	return ok
+}
+
+// HasAll looks for the specified elements to judge
+// whether all of them exist in the ApproxSet.
+func (a *ApproxSet[T]) HasAll(elements ...T) bool {
+	for _, element := range elements {
+		if !a.Has(element) {
+			return false
+		}
+	}
+	return true
+}
+
+// HasAny looks for the specified elements to judge
+// whether at least one of them exists in the ApproxSet.
+func (a *ApproxSet[T]) HasAny(elements ...T) bool {
+	for _, element := range elements {
+		if a.Has(element) {
+			return true
+		}
+	}
+	return false
+}
+
+// List returns the all members as a slice in ascending order, NaN first.
+func (a *ApproxSet[T]) List() []T {
+	v := make([]T, 0, a.n)
+	if a.nan {
+		v = append(v, T(math.NaN()))
+	}
+	for _, bucket := range a.buckets {
+		v = append(v, bucket...)
+	}
+	sortOrdered(v)
+	return v
+}
+
+// EachE traverses the members in the ApproxSet in ascending order, calling
+// do func for each ApproxSet member. the cycle will be stopped when the do
+// func returns error.
+// if err is ErrBreakEach, break the cycle and return nil,
+// else, break the cycle and return error.
+func (a *ApproxSet[T]) EachE(do func(i T) error) error {
+	for _, k := range a.List() {
+		if err := do(k); err != nil {
+			if err == ErrBreakEach {
+				return nil
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// Each traverses the members in the ApproxSet in ascending order, calling
+// do func for each ApproxSet member.
+func (a *ApproxSet[T]) Each(do func(i T)) {
+	for _, k := range a.List() {
+		do(k)
+	}
+}
+
+// Copy returns new ApproxSet that clones from ApproxSet, with the same epsilon.
+func (a *ApproxSet[T]) Copy() *ApproxSet[T] {
+	u := &ApproxSet[T]{epsilon: a.epsilon, buckets: make(map[int64][]T, len(a.buckets)), n: a.n, nan: a.nan}
+	for k, bucket := range a.buckets {
+		u.buckets[k] = append([]T(nil), bucket...)
+	}
+	return u
+}
+
+// filter returns a new ApproxSet with the epsilon and the members of
+// ApproxSet a for which pred returns true.
+func (a *ApproxSet[T]) filter(pred func(i T) bool) *ApproxSet[T] {
+	u := &ApproxSet[T]{epsilon: a.epsilon}
+	for _, element := range a.List() {
+		if pred(element) {
+			u.Add(element)
+		}
+	}
+	return u
+}
+
+// Union returns the union of ApproxSet a and b, the members of a and those
+// of b which are not within epsilon of a member of a.
+func (a *ApproxSet[T]) Union(b *ApproxSet[T]) *ApproxSet[T] {
+	u := a.Copy()
+	for _, element := range b.List() {
+		u.Add(element)
+	}
+	return u
+}
+
+// Difference returns the difference of ApproxSet a and b, the members of a
+// which are not in b.
+func (a *ApproxSet[T]) Difference(b *ApproxSet[T]) *ApproxSet[T] {
+	return a.filter(func(i T) bool {
+		return !b.Has(i)
+	})
+}
+
+// Intersection returns the intersection of ApproxSet a and b, the members of
+// a which are in b.
+func (a *ApproxSet[T]) Intersection(b *ApproxSet[T]) *ApproxSet[T] {
+	return a.filter(b.Has)
+}
+
+// SymmetricDifference returns a new ApproxSet with the members of ApproxSet
+// a which are not in b, and those of b which are not in a.
+func (a *ApproxSet[T]) SymmetricDifference(b *ApproxSet[T]) *ApproxSet[T] {
+	u := a.Difference(b)
+	for _, element := range b.List() {
+		if !a.Has(element) {
+			u.Add(element)
+		}
+	}
+	return u
+}
+
+// String returns a string representation of ApproxSet, the members are in
+// ascending order.
+func (a *ApproxSet[T]) String() string {
+	return joinElements(a.List(), "%v")
+}
+
+// Format implements fmt.Formatter, the members are in ascending order.
+// See Set.Format for the verbs, the %#v output starts with the epsilon as
+// the call to NewApproxSet does.
+func (a *ApproxSet[T]) Format(f fmt.State, verb rune) {
+	v := a.List()
+	if verb == 'v' && f.Flag('#') {
+		v = append([]T{a.epsilon}, v...)
+	}
+	formatElements(f, verb, v, "NewApproxSet["+typeName[T]()+"]")
+}
@@ -0,0 +1,307 @@
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package set
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"unicode/utf8"
+)
+
+// binaryVersion is the version of the binary encoding of Set.
+const binaryVersion = 1
+
+// the kinds of the elements in the binary encoding of Set, the integers are
+// encoded in 64 bits whatever their size, so a Set decodes into a Set of
+// another integer type of the same signedness if its elements fit.
+const (
+	intKind = iota + 1
+	uintKind
+	float32Kind
+	float64Kind
+	stringKind
+)
+
+// binaryKind returns the kind of the binary encoding of the elements of type
+// t, or 0 if they have no binary encoding.
+func binaryKind(t reflect.Type) byte {
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return intKind
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return uintKind
+	case reflect.Float32:
+		return float32Kind
+	case reflect.Float64:
+		return float64Kind
+	case reflect.String:
+		return stringKind
+	default:
+		return 0
+	}
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler for the sets of numbers
+// and strings, it returns an error for the other element types.
+//
+// The encoding is a version byte, a kind byte and the number of elements as
+// an uvarint, followed by the elements in ascending order:
+//   - integers as the first element, a zigzag varint for signed integers or
+//     an uvarint for unsigned ones, then the difference of each element to
+//     the previous one as an uvarint,
+//   - floats as their IEEE 754 bits, in the same way as unsigned integers,
+//   - strings as their length as an uvarint followed by their UTF-8 bytes.
+func (s Set[T]) MarshalBinary() ([]byte, error) {
+	v := s.List()
+	rv := reflect.ValueOf(v)
+	kind := binaryKind(rv.Type().Elem())
+	if kind == 0 {
+		return nil, fmt.Errorf("set: binary encoding of %s elements is not supported", typeName[T]())
+	}
+	data := make([]byte, 0, 3+binary.MaxVarintLen64*(1+len(v)))
+	data = append(data, binaryVersion, kind)
+	data = binary.AppendUvarint(data, uint64(len(v)))
+	switch kind {
+	case intKind:
+		x := make([]int64, len(v))
+		for i := range x {
+			x[i] = rv.Index(i).Int()
+		}
+		sort.Slice(x, func(i, j int) bool { return x[i] < x[j] })
+		for i := range x {
+			if i == 0 {
+				data = binary.AppendVarint(data, x[0])
+			} else {
+				data = binary.AppendUvarint(data, uint64(x[i])-uint64(x[i-1]))
+			}
+		}
+	case uintKind, float32Kind, float64Kind:
+		x := make([]uint64, len(v))
+		for i := range x {
+			switch kind {
+			case uintKind:
+				x[i] = rv.Index(i).Uint()
+			case float32Kind:
+				x[i] = uint64(math.Float32bits(float32(rv.Index(i).Float())))
+			default:
+				x[i] = math.Float64bits(rv.Index(i).Float())
+			}
+		}
+		sort.Slice(x, func(i, j int) bool { return x[i] < x[j] })
+		for i := range x {
+			if i == 0 {
+				data = binary.AppendUvarint(data, x[0])
+			} else {
+				data = binary.AppendUvarint(data, x[i]-x[i-1])
+			}
+		}
+	case stringKind:
+		x := make([]string, len(v))
+		for i := range x {
+			x[i] = rv.Index(i).String()
+			if !utf8.ValidString(x[i]) {
+				return nil, fmt.Errorf("set: binary encoding of invalid UTF-8 string %q", x[i])
+			}
+		}
+		sort.Strings(x)
+		for _, element := range x {
+			data = binary.AppendUvarint(data, uint64(len(element)))
+			data = append(data, element...)
+		}
+	}
+	return data, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, it replaces the Set
+// with the decoded elements. It returns an error wrapping ErrInvalidEncoding
+// if data is not a valid encoding of MarshalBinary for the element type, and
+// leaves the Set unchanged.
+func (s *Set[T]) UnmarshalBinary(data []byte) error {
+	invalid := func(format string, args ...interface{}) error {
+		return fmt.Errorf("set: binary %s: %w", fmt.Sprintf(format, args...), ErrInvalidEncoding)
+	}
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	target := binaryKind(t)
+	if target == 0 {
+		return fmt.Errorf("set: binary encoding of %s elements is not supported", typeName[T]())
+	}
+	d := decoder{data: data}
+	if version, ok := d.byte(); !ok || version != binaryVersion {
+		return invalid("unknown version")
+	}
+	if kind, ok := d.byte(); !ok || kind != target {
+		return invalid("elements of kind %d, expect %d", kind, target)
+	}
+	n, ok := d.uvarint()
+	if !ok || n > uint64(len(d.data)) {
+		return invalid("truncated size")
+	}
+	v := make([]T, n)
+	rv := reflect.ValueOf(v)
+	var prev uint64
+	var prevString string
+	for i := 0; i < int(n); i++ {
+		element := rv.Index(i)
+		switch target {
+		case intKind:
+			var x int64
+			if i == 0 {
+				x, ok = d.varint()
+			} else {
+				var delta uint64
+				delta, ok = d.uvarint()
+				x = int64(prev + delta)
+				ok = ok && delta != 0 && x > int64(prev)
+			}
+			if !ok || element.OverflowInt(x) {
+				return invalid("element %d", i)
+			}
+			element.SetInt(x)
+			prev = uint64(x)
+		case uintKind, float32Kind, float64Kind:
+			x, ok := d.uvarint()
+			if i > 0 {
+				ok = ok && prev+x >= prev
+				x += prev
+				// NaN is not equal to itself, so a Set may hold several
+				// NaNs with the same bits
+				ok = ok && (x != prev || nanBits(target, x))
+			}
+			switch {
+			case !ok:
+				return invalid("element %d", i)
+			case target == uintKind:
+				if element.OverflowUint(x) {
+					return invalid("element %d", i)
+				}
+				element.SetUint(x)
+			case target == float32Kind:
+				if x > math.MaxUint32 {
+					return invalid("element %d", i)
+				}
+				element.SetFloat(float64(math.Float32frombits(uint32(x))))
+			default:
+				element.SetFloat(math.Float64frombits(x))
+			}
+			prev = x
+		case stringKind:
+			size, ok := d.uvarint()
+			if !ok || !d.has(size) {
+				return invalid("element %d", i)
+			}
+			x := string(d.bytes(int(size)))
+			if !utf8.ValidString(x) || (i > 0 && x <= prevString) {
+				return invalid("element %d", i)
+			}
+			element.SetString(x)
+			prevString = x
+		}
+	}
+	if len(d.data) != 0 {
+		return invalid("trailing data")
+	}
+	u := NewWithSize[T](len(v))
+	for _, element := range v {
+		u[element] = struct{}{}
+	}
+	*s = u
+	return nil
+}
+
+// nanBits reports whether x are the bits of a NaN of the float kind.
+func nanBits(kind byte, x uint64) bool {
+	switch kind {
+	case float32Kind:
+		return x <= math.MaxUint32 && math.IsNaN(float64(math.Float32frombits(uint32(x))))
+	case float64Kind:
+		return math.IsNaN(math.Float64frombits(x))
+	default:
+		return false
+	}
+}
+
+// decoder reads the fields of a binary encoding.
+type decoder struct {
+	data []byte
+}
+
+func (d *decoder) has(n uint64) bool {
+	return n <= uint64(len(d.data))
+}
+
+func (d *decoder) byte() (byte, bool) {
+	if len(d.data) < 1 {
+		return 0, false
+	}
+	b := d.data[0]
+	d.data = d.data[1:]
+	return b, true
+}
+
+func (d *decoder) uvarint() (uint64, bool) {
+	x, n := binary.Uvarint(d.data)
+	if n <= 0 {
+		return 0, false
+	}
+	d.data = d.data[n:]
+	return x, true
+}
+
+func (d *decoder) varint() (int64, bool) {
+	x, n := binary.Varint(d.data)
+	if n <= 0 {
+		return 0, false
+	}
+	d.data = d.data[n:]
+	return x, true
+}
+
+// bytes returns the next n bytes, the caller must check has(n) first.
+func (d *decoder) bytes(n int) []byte {
+	b := d.data[:n]
+	d.data = d.data[n:]
+	return b
+}
+
+func (d *decoder) uint16() (uint16, bool) {
+	if len(d.data) < 2 {
+		return 0, false
+	}
+	x := binary.LittleEndian.Uint16(d.data)
+	d.data = d.data[2:]
+	return x, true
+}
+
+func (d *decoder) uint64() (uint64, bool) {
+	if len(d.data) < 8 {
+		return 0, false
+	}
+	x := binary.LittleEndian.Uint64(d.data)
+	d.data = d.data[8:]
+	return x, true
+}
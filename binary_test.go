@@ -0,0 +1,231 @@
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package set
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestSet_MarshalBinary(t *testing.T) {
+	testcases := []struct {
+		name   string
+		set    interface{ MarshalBinary() ([]byte, error) }
+		expect []byte
+	}{
+		{name: "empty", set: NewInt(), expect: []byte{1, intKind, 0}},
+		{name: "nil", set: Int(nil), expect: []byte{1, intKind, 0}},
+		{name: "int", set: NewInt(300, -1, 1), expect: []byte{1, intKind, 3, 1, 2, 0xab, 0x02}},
+		{name: "int64 bounds", set: NewInt64(math.MaxInt64, math.MinInt64), expect: append([]byte{1, intKind, 2, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01}, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01)},
+		{name: "uint8", set: NewUint8(255, 0, 1), expect: []byte{1, uintKind, 3, 0, 1, 0xfe, 0x01}},
+		{name: "float32", set: NewFloat32(1), expect: []byte{1, float32Kind, 1, 0x80, 0x80, 0x80, 0xfc, 0x03}},
+		{name: "string", set: NewString("b", "", "a"), expect: []byte{1, stringKind, 3, 0, 1, 'a', 1, 'b'}},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		actual, err := tc.set.MarshalBinary()
+		if err != nil || !bytes.Equal(actual, tc.expect) {
+			t.Errorf("expect encoding: %v, but got: %v, %v", tc.expect, actual, err)
+		}
+	}
+
+	if _, err := NewInterface(1).MarshalBinary(); err == nil {
+		t.Errorf("expect error on Interface elements")
+	}
+	if _, err := NewString("\xff").MarshalBinary(); err == nil {
+		t.Errorf("expect error on invalid UTF-8 string")
+	}
+}
+
+func TestSet_BinaryRoundTrip(t *testing.T) {
+	testBinaryRoundTrip(t, NewInt(0, 1, -1, math.MaxInt, math.MinInt))
+	testBinaryRoundTrip(t, NewInt8(0, 1, -1, math.MaxInt8, math.MinInt8))
+	testBinaryRoundTrip(t, NewInt16(0, 1, -1, math.MaxInt16, math.MinInt16))
+	testBinaryRoundTrip(t, NewInt32(0, 1, -1, math.MaxInt32, math.MinInt32))
+	testBinaryRoundTrip(t, NewInt64(0, 1, -1, math.MaxInt64, math.MinInt64))
+	testBinaryRoundTrip(t, NewUint(0, 1, math.MaxUint))
+	testBinaryRoundTrip(t, NewUint8(0, 1, math.MaxUint8))
+	testBinaryRoundTrip(t, NewUint16(0, 1, math.MaxUint16))
+	testBinaryRoundTrip(t, NewUint32(0, 1, math.MaxUint32))
+	testBinaryRoundTrip(t, NewUint64(0, 1, math.MaxUint64))
+	testBinaryRoundTrip(t, NewUintptr(0, 1, 1<<20))
+	testBinaryRoundTrip(t, NewFloat32(0, 1.5, -2.25, math.MaxFloat32, float32(math.Inf(-1))))
+	testBinaryRoundTrip(t, NewFloat64(0, 1.5, -2.25, math.SmallestNonzeroFloat64, math.Inf(1)))
+	testBinaryRoundTrip(t, NewString("", "a", "ab", "b", "世界"))
+	testBinaryRoundTrip(t, New[formatID](3, -4))
+
+	s := NewFloat64(1, math.NaN(), math.NaN())
+	data, _ := s.MarshalBinary()
+	var u Float64
+	if err := u.UnmarshalBinary(data); err != nil || u.Size() != 3 || !u.Has(1) {
+		t.Errorf("expect NaNs to round trip, but got: %v, %v", u, err)
+	}
+
+	// integers decode into a set of another size if they fit
+	data, _ = NewInt64(-128, 127).MarshalBinary()
+	var i8 Int8
+	if err := i8.UnmarshalBinary(data); err != nil || !i8.Equal(NewInt8(-128, 127)) {
+		t.Errorf("expect [-128, 127], but got: %v, %v", i8, err)
+	}
+	data, _ = NewInt64(128).MarshalBinary()
+	if err := i8.UnmarshalBinary(data); !errors.Is(err, ErrInvalidEncoding) || !i8.Equal(NewInt8(-128, 127)) {
+		t.Errorf("expect ErrInvalidEncoding and an unchanged set, but got: %v, %v", i8, err)
+	}
+
+	y := NewSync[uint16](1, 2)
+	data, _ = y.MarshalBinary()
+	z := NewSync[uint16]()
+	if err := z.UnmarshalBinary(data); err != nil || !z.Equal(y) {
+		t.Errorf("expect %v, but got: %v, %v", y, z, err)
+	}
+}
+
+func testBinaryRoundTrip[T comparable](t *testing.T, s Set[T]) {
+	t.Helper()
+	data, err := s.MarshalBinary()
+	if err != nil {
+		t.Fatalf("expect no error, but got: %v", err)
+	}
+	var u Set[T]
+	if err := u.UnmarshalBinary(data); err != nil || !u.Equal(s) {
+		t.Errorf("expect %v, but got: %v, %v", s, u, err)
+	}
+	for i := 0; i < len(data); i++ {
+		if err := u.UnmarshalBinary(data[:i]); !errors.Is(err, ErrInvalidEncoding) {
+			t.Errorf("expect ErrInvalidEncoding on %d of %d bytes, but got: %v", i, len(data), err)
+		}
+	}
+}
+
+func TestSet_UnmarshalBinary(t *testing.T) {
+	testcases := []struct {
+		name string
+		data []byte
+	}{
+		{name: "unknown version", data: []byte{2, intKind, 0}},
+		{name: "other kind", data: []byte{1, uintKind, 0}},
+		{name: "size larger than data", data: []byte{1, intKind, 3, 0, 1}},
+		{name: "duplicate element", data: []byte{1, intKind, 2, 0, 0}},
+		{name: "overflow", data: []byte{1, intKind, 2, 0xfe, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x02}},
+		{name: "trailing data", data: []byte{1, intKind, 1, 0, 0}},
+		{name: "overlong varint", data: []byte{1, intKind, 1, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01}},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		var s Int
+		if err := s.UnmarshalBinary(tc.data); !errors.Is(err, ErrInvalidEncoding) {
+			t.Errorf("expect ErrInvalidEncoding, but got: %v", err)
+		}
+	}
+
+	var s String
+	for _, data := range [][]byte{
+		{1, stringKind, 1, 1, 0xff},
+		{1, stringKind, 2, 1, 'b', 1, 'a'},
+		{1, stringKind, 2, 1, 'a', 1, 'a'},
+		{1, stringKind, 1, 5, 'a'},
+	} {
+		if err := s.UnmarshalBinary(data); !errors.Is(err, ErrInvalidEncoding) {
+			t.Errorf("expect ErrInvalidEncoding on: %v, but got: %v", data, err)
+		}
+	}
+	var u Uint8
+	if err := u.UnmarshalBinary([]byte{1, uintKind, 1, 0x80, 0x02}); !errors.Is(err, ErrInvalidEncoding) {
+		t.Errorf("expect ErrInvalidEncoding on uint8 overflow, but got: %v", err)
+	}
+	var i Interface
+	if err := i.UnmarshalBinary([]byte{1, intKind, 0}); err == nil || errors.Is(err, ErrInvalidEncoding) {
+		t.Errorf("expect unsupported error, but got: %v", err)
+	}
+}
+
+// fuzzBinary checks that decoding arbitrary data either fails with
+// ErrInvalidEncoding, or gives a Set which round trips.
+func fuzzBinary[T comparable](t *testing.T, data []byte) {
+	var s Set[T]
+	if err := s.UnmarshalBinary(data); err != nil {
+		if !errors.Is(err, ErrInvalidEncoding) {
+			t.Fatalf("expect ErrInvalidEncoding, but got: %v", err)
+		}
+		return
+	}
+	encoded, err := s.MarshalBinary()
+	if err != nil {
+		t.Fatalf("expect no error, but got: %v", err)
+	}
+	var u Set[T]
+	if err := u.UnmarshalBinary(encoded); err != nil || u.Size() != s.Size() {
+		t.Fatalf("expect %v, but got: %v, %v", s, u, err)
+	}
+	again, _ := u.MarshalBinary()
+	if !bytes.Equal(encoded, again) {
+		t.Fatalf("expect stable encoding: %v, but got: %v", encoded, again)
+	}
+}
+
+func FuzzSet_UnmarshalBinary(f *testing.F) {
+	for _, s := range []interface{ MarshalBinary() ([]byte, error) }{
+		NewInt64(0, -1, 300, math.MinInt64),
+		NewUint16(1, 2, 1000),
+		NewFloat64(1.5, math.NaN(), math.Inf(-1)),
+		NewString("", "a", "世界"),
+	} {
+		data, _ := s.MarshalBinary()
+		f.Add(data)
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		fuzzBinary[int64](t, data)
+		fuzzBinary[int8](t, data)
+		fuzzBinary[uint16](t, data)
+		fuzzBinary[float32](t, data)
+		fuzzBinary[float64](t, data)
+		fuzzBinary[string](t, data)
+	})
+}
+
+func FuzzSet_MarshalBinary(f *testing.F) {
+	f.Add([]byte{0, 1, 2, 255}, "a\x00b")
+	f.Fuzz(func(t *testing.T, raw []byte, str string) {
+		ints, floats, strings := NewInt32(), NewFloat64(), NewString()
+		for i := 0; i+4 <= len(raw); i += 4 {
+			x := uint32(raw[i]) | uint32(raw[i+1])<<8 | uint32(raw[i+2])<<16 | uint32(raw[i+3])<<24
+			ints.Add(int32(x))
+			floats.Add(float64(math.Float32frombits(x)))
+			strings.Add(string(raw[i:i+4]), str[:len(str)*i/len(raw)])
+		}
+		testBinaryRoundTrip(t, ints)
+		data, err := floats.MarshalBinary()
+		var u Float64
+		if err != nil || u.UnmarshalBinary(data) != nil || u.Size() != floats.Size() {
+			t.Fatalf("expect %v, but got: %v, %v", floats, u, err)
+		}
+		if _, err := strings.MarshalBinary(); err != nil {
+			return
+		}
+		testBinaryRoundTrip(t, strings)
+	})
+}
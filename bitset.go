@@ -0,0 +1,461 @@
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package set
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/bits"
+	"unsafe"
+)
+
+// Small is a constraint that permits the integer types of at most 16 bits,
+// whose domain is small enough to be stored as a dense bitset.
+type Small interface {
+	~int8 | ~uint8 | ~int16 | ~uint16
+}
+
+// Bitset is a collection of T that contains no duplicate elements, stored as
+// a dense bitset with one bit for every value of the domain of T: 256 bits
+// for the 8 bit types and 8 KiB for the 16 bit types. It has the same
+// methods as Set, Has and the set operations are a handful of word
+// operations, and the elements are traversed in ascending order.
+// The zero value is an empty Bitset ready to use.
+type Bitset[T Small] struct {
+	// words is nil until the first element is added.
+	words []uint64
+}
+
+// NewBitset initializes a new Bitset.
+func NewBitset[T Small](elements ...T) *Bitset[T] {
+	b := &Bitset[T]{}
+	b.Add(elements...)
+	return b
+}
+
+// NewBitsetFromSet initializes a new Bitset with the elements of Set s.
+func NewBitsetFromSet[T Small](s Set[T]) *Bitset[T] {
+	b := &Bitset[T]{}
+	for k := range s {
+		b.add(k)
+	}
+	return b
+}
+
+// ToSet returns a Set with the elements of Bitset.
+func (b *Bitset[T]) ToSet() Set[T] {
+	s := NewWithSize[T](b.Size())
+	b.Each(func(i T) {
+		s[i] = struct{}{}
+	})
+	return s
+}
+
+// bitsetWords returns the number of words needed by the domain of T.
+func bitsetWords[T Small]() int {
+	var zero T
+	return 1 << (8*unsafe.Sizeof(zero) - 6)
+}
+
+// bitIndex returns the bit of the element, the domain of signed types is
+// shifted so that the bits are in the order of the values.
+func bitIndex[T Small](element T) uint {
+	var zero T
+	if unsafe.Sizeof(zero) == 1 {
+		i := uint(uint8(element))
+		if ^zero < 0 {
+			i ^= 1 << 7
+		}
+		return i
+	}
+	i := uint(uint16(element))
+	if ^zero < 0 {
+		i ^= 1 << 15
+	}
+	return i
+}
+
+// bitValue is the inverse of bitIndex.
+func bitValue[T Small](i uint) T {
+	var zero T
+	if unsafe.Sizeof(zero) == 1 {
+		if ^zero < 0 {
+			i ^= 1 << 7
+		}
+		return T(uint8(i))
+	}
+	if ^zero < 0 {
+		i ^= 1 << 15
+	}
+	return T(uint16(i))
+}
+
+func (b *Bitset[T]) add(element T) {
+	if b.words == nil {
+		b.words = make([]uint64, bitsetWords[T]())
+	}
+	i := bitIndex(element)
+	b.words[i>>6] |= 1 << (i & 63)
+}
+
+// Add adds the elements to Bitset, if it is not present already.
+func (b *Bitset[T]) Add(elements ...T) {
+	for _, element := range elements {
+		b.add(element)
+	}
+}
+
+// Remove removes the element from Bitset, if it is present.
+func (b *Bitset[T]) Remove(elements ...T) {
+	if b.words == nil {
+		return
+	}
+	for _, element := range elements {
+		i := bitIndex(element)
+		b.words[i>>6] &^= 1 << (i & 63)
+	}
+}
+
+// Pop returns the smallest element of Bitset, deleting it from Bitset.
+// The second value is a bool that is true if the elements existed in
+// the Bitset, and false if not.
+func (b *Bitset[T]) Pop() (T, bool) {
+	for w, word := range b.words {
+		if word != 0 {
+			i := uint(bits.TrailingZeros64(word))
+			b.words[w] &^= 1 << i
+			return bitValue[T](uint(w)<<6 | i), true
+		}
+	}
+	var zero T
+	return zero, false
+}
+
+// Size returns the number of elements in Bitset.
+func (b *Bitset[T]) Size() int {
+	n := 0
+	for _, word := range b.words {
+		n += bits.OnesCount64(word)
+	}
+	return n
+}
+
+// IsEmpty returns whether the Bitset is Empty.
+func (b *Bitset[T]) IsEmpty() bool {
+	for _, word := range b.words {
+		if word != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Clear removes all items from the Bitset.
+func (b *Bitset[T]) Clear() {
+	for w := range b.words {
+		b.words[w] = 0
+	}
+}
+
+// Has judges the specified element whether exists in the Bitset.
+// it returns true if existed, and false if not.
+func (b *Bitset[T]) Has(element T) bool {
+	if b.words == nil {
+		return false
+	}
+	i := bitIndex(element)
+	return b.words[i>>6]&(1<<(i&63)) != 0
+}
+
+// HasAll looks for the specified elements to judge
+// whether all exist in the Bitset.
+// it returns true if existed, and false if not.
+func (b *Bitset[T]) HasAll(elements ...T) bool {
+	for _, element := range elements {
+		if !b.Has(element) {
+			return false
+		}
+	}
+	return true
+}
+
+// HasAny looks for the specified elements to judge
+// whether at least one of the element exists in the Bitset.
+// it returns true if existed, and false if not.
+func (b *Bitset[T]) HasAny(elements ...T) bool {
+	for _, element := range elements {
+		if b.Has(element) {
+			return true
+		}
+	}
+	return false
+}
+
+// List returns the all elements as a slice in ascending order.
+func (b *Bitset[T]) List() []T {
+	var dest []T
+	b.Each(func(i T) {
+		dest = append(dest, i)
+	})
+	return dest
+}
+
+// SortedList returns the all elements as a slice sorted by less func.
+func (b *Bitset[T]) SortedList(less func(i, j T) bool) []T {
+	return b.ToSet().SortedList(less)
+}
+
+// EachE traverses the elements in the Bitset in ascending order, calling do
+// func for each Bitset member. the cycle will be stopped when the do func
+// returns error. if err is ErrBreakEach, break the cycle and return nil,
+// else, break the cycle and return error.
+func (b *Bitset[T]) EachE(do func(i T) error) error {
+	for w, word := range b.words {
+		for word != 0 {
+			i := uint(bits.TrailingZeros64(word))
+			word &^= 1 << i
+			if err := do(bitValue[T](uint(w)<<6 | i)); err != nil {
+				if err == ErrBreakEach {
+					return nil
+				}
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Each traverses the elements in the Bitset in ascending order, calling do
+// func for each Bitset member.
+func (b *Bitset[T]) Each(do func(i T)) {
+	for w, word := range b.words {
+		for word != 0 {
+			i := uint(bits.TrailingZeros64(word))
+			word &^= 1 << i
+			do(bitValue[T](uint(w)<<6 | i))
+		}
+	}
+}
+
+// Filter returns a new Bitset with the elements of Bitset b for which pred
+// returns true.
+func (b *Bitset[T]) Filter(pred func(i T) bool) *Bitset[T] {
+	in, _ := partitionElements(b.Each, pred)
+	return NewBitset(in...)
+}
+
+// Partition returns two new Bitsets, the first with the elements of Bitset b
+// for which pred returns true, the second with the others.
+func (b *Bitset[T]) Partition(pred func(i T) bool) (*Bitset[T], *Bitset[T]) {
+	in, out := partitionElements(b.Each, pred)
+	return NewBitset(in...), NewBitset(out...)
+}
+
+// Any reports whether pred returns true for any element of Bitset b, the
+// traversal stops at the first such element.
+func (b *Bitset[T]) Any(pred func(i T) bool) bool {
+	_, ok := findElement(b.EachE, pred)
+	return ok
+}
+
+// Every reports whether pred returns true for every element of Bitset b,
+// the traversal stops at the first element for which pred returns false.
+func (b *Bitset[T]) Every(pred func(i T) bool) bool {
+	_, ok := findElement(b.EachE, func(i T) bool {
+		return !pred(i)
+	})
+	return !ok
+}
+
+// Find returns the smallest element of Bitset b for which pred returns true.
+// The second value is false if there is no such element.
+func (b *Bitset[T]) Find(pred func(i T) bool) (T, bool) {
+	return findElement(b.EachE, pred)
+}
+
+// Count returns the number of elements of Bitset b for which pred returns
+// true.
+func (b *Bitset[T]) Count(pred func(i T) bool) int {
+	return countElements(b.Each, pred)
+}
+
+// word returns the w-th word of Bitset, a Bitset without words is empty.
+func (b *Bitset[T]) word(w int) uint64 {
+	if b.words == nil {
+		return 0
+	}
+	return b.words[w]
+}
+
+// combine returns a new Bitset whose words are op of the words of b and c.
+func (b *Bitset[T]) combine(c *Bitset[T], op func(x, y uint64) uint64) *Bitset[T] {
+	u := &Bitset[T]{words: make([]uint64, bitsetWords[T]())}
+	for w := range u.words {
+		u.words[w] = op(b.word(w), c.word(w))
+	}
+	return u
+}
+
+// update sets the words of b to op of the words of b and c.
+func (b *Bitset[T]) update(c *Bitset[T], op func(x, y uint64) uint64) {
+	if b.words == nil {
+		b.words = make([]uint64, bitsetWords[T]())
+	}
+	for w := range b.words {
+		b.words[w] = op(b.words[w], c.word(w))
+	}
+}
+
+func orWord(x, y uint64) uint64     { return x | y }
+func andNotWord(x, y uint64) uint64 { return x &^ y }
+func andWord(x, y uint64) uint64    { return x & y }
+func xorWord(x, y uint64) uint64    { return x ^ y }
+
+// Union returns the union of Bitset b and c.
+func (b *Bitset[T]) Union(c *Bitset[T]) *Bitset[T] {
+	return b.combine(c, orWord)
+}
+
+// Difference returns the difference of Bitset b and c.
+func (b *Bitset[T]) Difference(c *Bitset[T]) *Bitset[T] {
+	return b.combine(c, andNotWord)
+}
+
+// Intersection returns the intersection of Bitset b and c.
+func (b *Bitset[T]) Intersection(c *Bitset[T]) *Bitset[T] {
+	return b.combine(c, andWord)
+}
+
+// SymmetricDifference returns a new Bitset with the elements that are either in this Bitset
+// or in the given Bitset, but not in both.
+func (b *Bitset[T]) SymmetricDifference(c *Bitset[T]) *Bitset[T] {
+	return b.combine(c, xorWord)
+}
+
+// UnionWith adds all elements of Bitset c to Bitset b.
+func (b *Bitset[T]) UnionWith(c *Bitset[T]) {
+	b.update(c, orWord)
+}
+
+// DifferenceWith removes all elements of Bitset c from Bitset b.
+func (b *Bitset[T]) DifferenceWith(c *Bitset[T]) {
+	b.update(c, andNotWord)
+}
+
+// IntersectWith removes the elements of Bitset b which are not in Bitset c.
+func (b *Bitset[T]) IntersectWith(c *Bitset[T]) {
+	b.update(c, andWord)
+}
+
+// SymmetricDifferenceWith keeps the elements that are either in Bitset b or in
+// Bitset c, but not in both.
+func (b *Bitset[T]) SymmetricDifferenceWith(c *Bitset[T]) {
+	b.update(c, xorWord)
+}
+
+// IsSubset predicates that tests whether the Bitset b is a subset of Bitset c.
+func (b *Bitset[T]) IsSubset(c *Bitset[T]) bool {
+	for w, word := range b.words {
+		if word&^c.word(w) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSuperset predicates that tests whether the Bitset b is a super of Bitset c.
+func (b *Bitset[T]) IsSuperset(c *Bitset[T]) bool {
+	return c.IsSubset(b)
+}
+
+// Equal predicates that tests whether the Bitset b equals of Bitset c.
+func (b *Bitset[T]) Equal(c *Bitset[T]) bool {
+	return b.IsSubset(c) && c.IsSubset(b)
+}
+
+// Copy returns new Bitset that clones from Bitset.
+func (b *Bitset[T]) Copy() *Bitset[T] {
+	u := &Bitset[T]{}
+	if b.words != nil {
+		u.words = make([]uint64, len(b.words))
+		copy(u.words, b.words)
+	}
+	return u
+}
+
+// String returns a string representation of Bitset
+func (b *Bitset[T]) String() string {
+	return joinElements(b.List(), "%v")
+}
+
+// Format implements fmt.Formatter, the elements are in ascending order.
+// See Set.Format for the verbs.
+func (b *Bitset[T]) Format(f fmt.State, verb rune) {
+	formatElements(f, verb, b.List(), "NewBitset["+typeName[T]()+"]")
+}
+
+// MarshalJSON implements json.Marshaler, it encodes the Bitset as a JSON
+// array in ascending order.
+func (b *Bitset[T]) MarshalJSON() ([]byte, error) {
+	v := make([]int, 0, b.Size())
+	b.Each(func(i T) {
+		v = append(v, int(i))
+	})
+	return json.Marshal(v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, it replaces the Bitset with the
+// elements of a JSON array, merging duplicate elements.
+func (b *Bitset[T]) UnmarshalJSON(data []byte) error {
+	var v []T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	b.Clear()
+	b.Add(v...)
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler, it encodes the Bitset as a
+// sequence in ascending order.
+func (b *Bitset[T]) MarshalYAML() (interface{}, error) {
+	v := make([]int, 0, b.Size())
+	b.Each(func(i T) {
+		v = append(v, int(i))
+	})
+	return v, nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, it replaces the Bitset with the
+// elements of a sequence, merging duplicate elements.
+func (b *Bitset[T]) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var v []T
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	b.Clear()
+	b.Add(v...)
+	return nil
+}
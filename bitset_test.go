@@ -0,0 +1,212 @@
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package set
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestBitset(t *testing.T) {
+	t.Run("Int8", func(t *testing.T) { testBitset[int8](t, math.MinInt8, math.MaxInt8) })
+	t.Run("Uint8", func(t *testing.T) { testBitset[uint8](t, 0, math.MaxUint8) })
+	t.Run("Int16", func(t *testing.T) { testBitset[int16](t, math.MinInt16, math.MaxInt16) })
+	t.Run("Uint16", func(t *testing.T) { testBitset[uint16](t, 0, math.MaxUint16) })
+}
+
+// testBitset checks every operation of Bitset against the same operation of
+// Set on random elements, including the bounds of the domain.
+func testBitset[T Small](t *testing.T, min, max T) {
+	r := rand.New(rand.NewSource(1))
+	random := func() Set[T] {
+		s := New(min, max)
+		for i := r.Intn(64); i > 0; i-- {
+			s.Add(T(r.Intn(int(max)-int(min)+1) + int(min)))
+		}
+		return s
+	}
+	less := func(i, j T) bool { return i < j }
+	for n := 0; n < 50; n++ {
+		s, u := random(), random()
+		b, c := NewBitsetFromSet(s), NewBitsetFromSet(u)
+		validateSet(t, b.ToSet(), s.List())
+		if b.Size() != s.Size() || b.IsEmpty() {
+			t.Errorf("expect size: %d, but got: %d", s.Size(), b.Size())
+		}
+		list := b.List()
+		sorted := s.SortedList(less)
+		for i := range sorted {
+			if list[i] != sorted[i] {
+				t.Fatalf("expect ascending list: %v, but got: %v", sorted, list)
+			}
+		}
+		if expect := fmt.Sprintf("%v", sorted); b.String() != strings.ReplaceAll(expect, " ", ", ") {
+			t.Errorf("expect string: %s, but got: %s", expect, b)
+		}
+		validateSet(t, b.Union(c).ToSet(), s.Union(u).List())
+		validateSet(t, b.Difference(c).ToSet(), s.Difference(u).List())
+		validateSet(t, b.Intersection(c).ToSet(), s.Intersection(u).List())
+		validateSet(t, b.SymmetricDifference(c).ToSet(), s.SymmetricDifference(u).List())
+		if b.IsSubset(c) != s.IsSubset(u) || b.IsSuperset(c) != s.IsSuperset(u) || b.Equal(c) != s.Equal(u) {
+			t.Errorf("unexpected predicates on: %s and %s", b, c)
+		}
+		if !b.Intersection(c).IsSubset(b) || !b.Equal(b.Copy()) {
+			t.Errorf("unexpected predicates on: %s", b)
+		}
+
+		w, v := b.Copy(), s.Copy()
+		w.UnionWith(c)
+		v.UnionWith(u)
+		validateSet(t, w.ToSet(), v.List())
+		w.SymmetricDifferenceWith(c)
+		v.SymmetricDifferenceWith(u)
+		validateSet(t, w.ToSet(), v.List())
+		w.IntersectWith(b)
+		v.IntersectWith(s)
+		validateSet(t, w.ToSet(), v.List())
+		w.DifferenceWith(c)
+		v.DifferenceWith(u)
+		validateSet(t, w.ToSet(), v.List())
+	}
+
+	b := NewBitset(max, min)
+	if !b.Has(min) || !b.Has(max) || b.Has(min+1) || !b.HasAll(min, max) || !b.HasAny(min+1, max) || b.HasAny(min+1) {
+		t.Errorf("unexpected predicates on: %s", b)
+	}
+	if k, ok := b.Pop(); !ok || k != min {
+		t.Errorf("expect to pop the smallest element %v, but got: %v", min, k)
+	}
+	b.Remove(max)
+	if _, ok := b.Pop(); ok || !b.IsEmpty() {
+		t.Errorf("expect empty set, but got: %s", b)
+	}
+	var visited []T
+	NewBitset(max, min, min+1).EachE(func(i T) error {
+		visited = append(visited, i)
+		if len(visited) == 2 {
+			return ErrBreakEach
+		}
+		return nil
+	})
+	if len(visited) != 2 || visited[0] != min || visited[1] != min+1 {
+		t.Errorf("expect visited: [%v %v], but got: %v", min, min+1, visited)
+	}
+}
+
+func TestBitset_Zero(t *testing.T) {
+	var b, c Bitset[uint8]
+	if !b.IsEmpty() || b.Has(0) || b.Size() != 0 || !b.Equal(&c) || b.Union(&c).Size() != 0 {
+		t.Errorf("expect zero Bitset to be empty")
+	}
+	if _, ok := b.Pop(); ok {
+		t.Errorf("expect Pop on zero Bitset to fail")
+	}
+	b.Remove(1)
+	b.UnionWith(NewBitset[uint8](1))
+	if !b.Has(1) || b.String() != "[1]" {
+		t.Errorf("expect [1], but got: %s", b.String())
+	}
+}
+
+func TestBitset_JSON(t *testing.T) {
+	b := NewBitset[int8](3, -128, 1)
+	data, err := json.Marshal(b)
+	if err != nil || string(data) != "[-128,1,3]" {
+		t.Fatalf("expect json: [-128,1,3], but got: %s, %v", data, err)
+	}
+	c := NewBitset[int8](9)
+	if err := json.Unmarshal(data, c); err != nil || !c.Equal(b) {
+		t.Errorf("expect %s, but got: %s, %v", b, c, err)
+	}
+	u := NewBitset[uint8]()
+	if err := json.Unmarshal([]byte("[255, 0, 0]"), u); err != nil || u.String() != "[0, 255]" {
+		t.Errorf("expect [0, 255], but got: %s, %v", u, err)
+	}
+}
+
+func benchmarkSets(n int) (Set[uint16], Set[uint16]) {
+	r := rand.New(rand.NewSource(1))
+	s, u := NewWithSize[uint16](n), NewWithSize[uint16](n)
+	for i := 0; i < n; i++ {
+		s.Add(uint16(r.Intn(math.MaxUint16)))
+		u.Add(uint16(r.Intn(math.MaxUint16)))
+	}
+	return s, u
+}
+
+func BenchmarkBitset_Has(b *testing.B) {
+	s, _ := benchmarkSets(10000)
+	bs := NewBitsetFromSet(s)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		bs.Has(uint16(i))
+	}
+}
+
+func BenchmarkSet_Has(b *testing.B) {
+	s, _ := benchmarkSets(10000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.Has(uint16(i))
+	}
+}
+
+func BenchmarkBitset_Union(b *testing.B) {
+	s, u := benchmarkSets(10000)
+	bs, bu := NewBitsetFromSet(s), NewBitsetFromSet(u)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		bs.Union(bu)
+	}
+}
+
+func BenchmarkSet_Union(b *testing.B) {
+	s, u := benchmarkSets(10000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.Union(u)
+	}
+}
+
+func BenchmarkBitset_Intersection(b *testing.B) {
+	s, u := benchmarkSets(10000)
+	bs, bu := NewBitsetFromSet(s), NewBitsetFromSet(u)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		bs.Intersection(bu)
+	}
+}
+
+func BenchmarkSet_Intersection(b *testing.B) {
+	s, u := benchmarkSets(10000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.Intersection(u)
+	}
+}
@@ -0,0 +1,420 @@
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package set
+
+import (
+	"math/bits"
+	"sort"
+)
+
+// The containers of a Roaring hold the low 16 bits of the elements which
+// share the same high bits, in one of three representations:
+//   - arrayContainer, a sorted array, for at most arrayMax elements,
+//   - bitmapContainer, a 2^16 bits bitmap, for more than arrayMax elements,
+//   - runContainer, sorted runs of consecutive values, only produced by
+//     Roaring.RunOptimize when it is the smallest representation.
+//
+// Mutating methods return the container which holds the result, it is the
+// receiver unless the representation had to change.
+type container interface {
+	card() int
+	has(x uint16) bool
+	add(x uint16) container
+	remove(x uint16) container
+	// each calls do for the values in ascending order until do returns false,
+	// it returns false if the traversal was stopped.
+	each(do func(x uint16) bool) bool
+	// next returns the smallest value which is not less than x, the second
+	// value is false if there is none.
+	next(x uint16) (uint16, bool)
+	clone() container
+	// words returns the bitmap of the container, the caller must not modify it.
+	words() *[bitmapWords]uint64
+	// size returns the number of bytes of the serialized container.
+	size() int
+}
+
+const (
+	// arrayMax is the maximum number of elements of an arrayContainer, a
+	// larger array would take more room than a bitmap.
+	arrayMax = 4096
+	// bitmapWords is the number of words of a bitmapContainer.
+	bitmapWords = 1 << 16 / 64
+)
+
+type arrayContainer struct {
+	values []uint16
+}
+
+func (a *arrayContainer) card() int {
+	return len(a.values)
+}
+
+func (a *arrayContainer) search(x uint16) (int, bool) {
+	i := sort.Search(len(a.values), func(i int) bool {
+		return a.values[i] >= x
+	})
+	return i, i < len(a.values) && a.values[i] == x
+}
+
+func (a *arrayContainer) has(x uint16) bool {
+	_, ok := a.search(x)
+	return ok
+}
+
+func (a *arrayContainer) add(x uint16) container {
+	i, ok := a.search(x)
+	if ok {
+		return a
+	}
+	if len(a.values) == arrayMax {
+		return newBitmapContainer(a.words()).add(x)
+	}
+	a.values = append(a.values, 0)
+	copy(a.values[i+1:], a.values[i:])
+	a.values[i] = x
+	return a
+}
+
+func (a *arrayContainer) remove(x uint16) container {
+	if i, ok := a.search(x); ok {
+		a.values = append(a.values[:i], a.values[i+1:]...)
+	}
+	return a
+}
+
+func (a *arrayContainer) each(do func(x uint16) bool) bool {
+	for _, x := range a.values {
+		if !do(x) {
+			return false
+		}
+	}
+	return true
+}
+
+func (a *arrayContainer) next(x uint16) (uint16, bool) {
+	if i, _ := a.search(x); i < len(a.values) {
+		return a.values[i], true
+	}
+	return 0, false
+}
+
+func (a *arrayContainer) clone() container {
+	values := make([]uint16, len(a.values))
+	copy(values, a.values)
+	return &arrayContainer{values: values}
+}
+
+func (a *arrayContainer) words() *[bitmapWords]uint64 {
+	var w [bitmapWords]uint64
+	for _, x := range a.values {
+		w[x>>6] |= 1 << (x & 63)
+	}
+	return &w
+}
+
+func (a *arrayContainer) size() int {
+	return 2 * len(a.values)
+}
+
+type bitmapContainer struct {
+	bits [bitmapWords]uint64
+	n    int
+}
+
+// newBitmapContainer returns a bitmapContainer holding a copy of the words.
+func newBitmapContainer(w *[bitmapWords]uint64) *bitmapContainer {
+	b := &bitmapContainer{bits: *w}
+	for _, word := range b.bits {
+		b.n += bits.OnesCount64(word)
+	}
+	return b
+}
+
+func (b *bitmapContainer) card() int {
+	return b.n
+}
+
+func (b *bitmapContainer) has(x uint16) bool {
+	return b.bits[x>>6]&(1<<(x&63)) != 0
+}
+
+func (b *bitmapContainer) add(x uint16) container {
+	if !b.has(x) {
+		b.bits[x>>6] |= 1 << (x & 63)
+		b.n++
+	}
+	return b
+}
+
+func (b *bitmapContainer) remove(x uint16) container {
+	if !b.has(x) {
+		return b
+	}
+	b.bits[x>>6] &^= 1 << (x & 63)
+	b.n--
+	if b.n <= arrayMax {
+		return b.toArray()
+	}
+	return b
+}
+
+func (b *bitmapContainer) toArray() *arrayContainer {
+	a := &arrayContainer{values: make([]uint16, 0, b.n)}
+	b.each(func(x uint16) bool {
+		a.values = append(a.values, x)
+		return true
+	})
+	return a
+}
+
+func (b *bitmapContainer) each(do func(x uint16) bool) bool {
+	for w, word := range b.bits {
+		for word != 0 {
+			i := bits.TrailingZeros64(word)
+			word &^= 1 << i
+			if !do(uint16(w<<6 | i)) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (b *bitmapContainer) next(x uint16) (uint16, bool) {
+	w := int(x >> 6)
+	word := b.bits[w] &^ (1<<(x&63) - 1)
+	for word == 0 {
+		if w++; w == bitmapWords {
+			return 0, false
+		}
+		word = b.bits[w]
+	}
+	return uint16(w<<6 | bits.TrailingZeros64(word)), true
+}
+
+func (b *bitmapContainer) clone() container {
+	c := *b
+	return &c
+}
+
+func (b *bitmapContainer) words() *[bitmapWords]uint64 {
+	return &b.bits
+}
+
+func (b *bitmapContainer) size() int {
+	return 8 * bitmapWords
+}
+
+// interval is a run of the consecutive values from start to last, inclusive.
+type interval struct {
+	start, last uint16
+}
+
+type runContainer struct {
+	runs []interval
+}
+
+func (r *runContainer) card() int {
+	n := 0
+	for _, run := range r.runs {
+		n += int(run.last-run.start) + 1
+	}
+	return n
+}
+
+func (r *runContainer) has(x uint16) bool {
+	i := sort.Search(len(r.runs), func(i int) bool {
+		return r.runs[i].last >= x
+	})
+	return i < len(r.runs) && r.runs[i].start <= x
+}
+
+// expand returns the container in the array or bitmap representation, which
+// is what the mutations of a runContainer work on.
+func (r *runContainer) expand() container {
+	if r.card() > arrayMax {
+		return newBitmapContainer(r.words())
+	}
+	a := &arrayContainer{values: make([]uint16, 0, r.card())}
+	r.each(func(x uint16) bool {
+		a.values = append(a.values, x)
+		return true
+	})
+	return a
+}
+
+func (r *runContainer) add(x uint16) container {
+	if r.has(x) {
+		return r
+	}
+	return r.expand().add(x)
+}
+
+func (r *runContainer) remove(x uint16) container {
+	if !r.has(x) {
+		return r
+	}
+	return r.expand().remove(x)
+}
+
+func (r *runContainer) each(do func(x uint16) bool) bool {
+	for _, run := range r.runs {
+		for x := int(run.start); x <= int(run.last); x++ {
+			if !do(uint16(x)) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (r *runContainer) next(x uint16) (uint16, bool) {
+	i := sort.Search(len(r.runs), func(i int) bool {
+		return r.runs[i].last >= x
+	})
+	switch {
+	case i == len(r.runs):
+		return 0, false
+	case r.runs[i].start > x:
+		return r.runs[i].start, true
+	default:
+		return x, true
+	}
+}
+
+func (r *runContainer) clone() container {
+	runs := make([]interval, len(r.runs))
+	copy(runs, r.runs)
+	return &runContainer{runs: runs}
+}
+
+func (r *runContainer) words() *[bitmapWords]uint64 {
+	var w [bitmapWords]uint64
+	for _, run := range r.runs {
+		for x := int(run.start); x <= int(run.last); x++ {
+			w[x>>6] |= 1 << (x & 63)
+		}
+	}
+	return &w
+}
+
+func (r *runContainer) size() int {
+	return 4 * len(r.runs)
+}
+
+// optimize returns the smallest representation of the container.
+func optimize(c container) container {
+	var runs []interval
+	c.each(func(x uint16) bool {
+		if n := len(runs); n > 0 && runs[n-1].last+1 == x {
+			runs[n-1].last = x
+		} else {
+			runs = append(runs, interval{start: x, last: x})
+		}
+		return true
+	})
+	if r := (&runContainer{runs: runs}); r.size() < c.size() {
+		return r
+	}
+	if r, ok := c.(*runContainer); ok {
+		return r.expand()
+	}
+	return c
+}
+
+// container operations.
+const (
+	opOr = iota
+	opAnd
+	opAndNot
+	opXor
+)
+
+// combineContainers returns op of containers a and b, or nil if the result is
+// empty. Neither a nor b is modified.
+func combineContainers(a, b container, op int) container {
+	x, xok := a.(*arrayContainer)
+	y, yok := b.(*arrayContainer)
+	if xok && yok {
+		return combineArrays(x.values, y.values, op)
+	}
+	wa, wb := a.words(), b.words()
+	var w [bitmapWords]uint64
+	for i := range w {
+		switch op {
+		case opOr:
+			w[i] = wa[i] | wb[i]
+		case opAnd:
+			w[i] = wa[i] & wb[i]
+		case opAndNot:
+			w[i] = wa[i] &^ wb[i]
+		case opXor:
+			w[i] = wa[i] ^ wb[i]
+		}
+	}
+	c := newBitmapContainer(&w)
+	switch {
+	case c.n == 0:
+		return nil
+	case c.n <= arrayMax:
+		return c.toArray()
+	default:
+		return c
+	}
+}
+
+// combineArrays merges the sorted values of two array containers.
+func combineArrays(x, y []uint16, op int) container {
+	var values []uint16
+	i, j := 0, 0
+	for i < len(x) || j < len(y) {
+		switch {
+		case j == len(y) || (i < len(x) && x[i] < y[j]):
+			if op != opAnd {
+				values = append(values, x[i])
+			}
+			i++
+		case i == len(x) || y[j] < x[i]:
+			if op == opOr || op == opXor {
+				values = append(values, y[j])
+			}
+			j++
+		default:
+			if op == opOr || op == opAnd {
+				values = append(values, x[i])
+			}
+			i++
+			j++
+		}
+	}
+	if len(values) == 0 {
+		return nil
+	}
+	if len(values) > arrayMax {
+		return newBitmapContainer((&arrayContainer{values: values}).words())
+	}
+	return &arrayContainer{values: values}
+}
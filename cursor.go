@@ -0,0 +1,306 @@
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package set
+
+import (
+	"encoding/base64"
+	"fmt"
+)
+
+// cursorVersion is the version of the encoding of Cursor.
+const cursorVersion = 1
+
+// Cursor is the position of an Iterator after the last element it returned,
+// it is an opaque URL safe token which can be stored or sent to a client, and
+// given to IteratorAfter to resume the traversal later, even from another
+// process. The empty Cursor is the position before the first element.
+//
+// A Cursor holds the last element returned, so it can only be taken for the
+// sets of numbers and strings which have a binary encoding.
+type Cursor string
+
+// ModifiedError is returned by Iterator.Err when a fail-fast Iterator detects
+// that its set was modified after the Iterator was created. Returned is the
+// number of elements returned before, the Cursor of the Iterator is still
+// valid to resume the traversal on the modified set.
+type ModifiedError struct {
+	Returned int
+}
+
+func (e *ModifiedError) Error() string {
+	return fmt.Sprintf("set: modified during iteration after %d elements", e.Returned)
+}
+
+// Iterator is an external iterator over the elements of a set in their
+// natural order, for traversals which are paused and resumed, such as
+// pagination:
+//
+//	it, err := s.IteratorAfter(cursor)
+//	for i := 0; i < 1000 && it.Next(); i++ {
+//		page = append(page, it.Value())
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+//	next, err := it.Cursor()
+//
+// Iterators of Set and Sync traverse a snapshot taken when they are created,
+// later changes of the set are not reflected. Iterators of OrderedSet
+// traverse the set itself and fail fast with a *ModifiedError if it is
+// modified. Iterators of Roaring traverse the set itself and return the
+// element which follows the last one on each call to Next, so that they
+// reflect the changes after it.
+type Iterator[T comparable] struct {
+	next  func(after T, started bool) (T, bool, error)
+	value T
+	// started reports whether value holds an element returned by Next.
+	started bool
+	start   Cursor
+	n       int
+	err     error
+	done    bool
+}
+
+// Next advances the Iterator to the next element, which is then available
+// through Value. It returns false when there are no more elements or an
+// error occurred, Err tells which.
+func (it *Iterator[T]) Next() bool {
+	if it.done || it.err != nil {
+		return false
+	}
+	v, ok, err := it.next(it.value, it.started)
+	if err != nil {
+		it.err = err
+		return false
+	}
+	if !ok {
+		it.done = true
+		return false
+	}
+	it.value, it.started = v, true
+	it.n++
+	return true
+}
+
+// Value returns the element of the last call to Next which returned true.
+func (it *Iterator[T]) Value() T {
+	return it.value
+}
+
+// Err returns the error which stopped the Iterator, it is nil if the
+// Iterator ran out of elements.
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// Cursor returns the position of the Iterator after the element returned by
+// Value, or the Cursor it was resumed from if Next has not returned an element
+// yet. It returns an error if the elements have no binary encoding.
+func (it *Iterator[T]) Cursor() (Cursor, error) {
+	if !it.started {
+		return it.start, nil
+	}
+	data, err := New(it.value).MarshalBinary()
+	if err != nil {
+		return "", err
+	}
+	return Cursor(base64.RawURLEncoding.EncodeToString(append([]byte{cursorVersion}, data...))), nil
+}
+
+// decodeCursor returns the element of Cursor c.
+func decodeCursor[T comparable](c Cursor) (T, error) {
+	var zero T
+	data, err := base64.RawURLEncoding.DecodeString(string(c))
+	if err != nil {
+		return zero, fmt.Errorf("set: cursor %v: %w", err, ErrInvalidEncoding)
+	}
+	if len(data) == 0 || data[0] != cursorVersion {
+		return zero, fmt.Errorf("set: cursor unknown version: %w", ErrInvalidEncoding)
+	}
+	var s Set[T]
+	if err := s.UnmarshalBinary(data[1:]); err != nil {
+		return zero, err
+	}
+	if len(s) != 1 {
+		return zero, fmt.Errorf("set: cursor holds %d elements: %w", len(s), ErrInvalidEncoding)
+	}
+	for element := range s {
+		return element, nil
+	}
+	return zero, nil
+}
+
+// sliceIterator returns an Iterator over the elements, which are sorted.
+func sliceIterator[T comparable](elements []T, start Cursor) *Iterator[T] {
+	i := 0
+	return &Iterator[T]{
+		next: func(T, bool) (T, bool, error) {
+			if i == len(elements) {
+				var zero T
+				return zero, false, nil
+			}
+			i++
+			return elements[i-1], true, nil
+		},
+		start: start,
+	}
+}
+
+// Iterator returns an Iterator over a snapshot of the elements of Set in
+// their natural order, sorting them takes O(n log n) when it is created.
+func (s Set[T]) Iterator() *Iterator[T] {
+	v := s.List()
+	sortElements(v)
+	return sliceIterator(v, "")
+}
+
+// IteratorAfter returns an Iterator over a snapshot of the elements of Set
+// which come after Cursor c in their natural order, it resumes a traversal
+// on the Set as it is now: the elements added after c are returned, those
+// removed are not. The empty Cursor starts from the first element.
+//
+// Resuming takes O(n) to select the r remaining elements and O(r log r) to
+// sort them, so that walking a large Set page by page is quadratic: a Set of
+// 10M elements read 1000 at a time is selected and sorted 10k times. Keep
+// the Iterator between pages where possible, or store large sets in a
+// Roaring or an OrderedSet, whose IteratorAfter resumes in O(log n).
+func (s Set[T]) IteratorAfter(c Cursor) (*Iterator[T], error) {
+	if c == "" {
+		return s.Iterator(), nil
+	}
+	after, err := decodeCursor[T](c)
+	if err != nil {
+		return nil, err
+	}
+	var v []T
+	for k := range s {
+		if compareAny(k, after) > 0 {
+			v = append(v, k)
+		}
+	}
+	sortElements(v)
+	return sliceIterator(v, c), nil
+}
+
+// Iterator returns an Iterator over a snapshot of the elements in the Sync
+// in their natural order, as Set.Iterator does.
+func (s *Sync[T]) Iterator() *Iterator[T] {
+	return s.Snapshot().Iterator()
+}
+
+// IteratorAfter returns an Iterator over a snapshot of the elements in the
+// Sync which come after Cursor c, as Set.IteratorAfter does.
+func (s *Sync[T]) IteratorAfter(c Cursor) (*Iterator[T], error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.s.IteratorAfter(c)
+}
+
+// Iterator returns a fail-fast Iterator over the elements of OrderedSet in
+// ascending order. It does not copy the OrderedSet, each call to Next takes
+// O(log n), and it stops with a *ModifiedError if the OrderedSet is modified.
+func (o *OrderedSet[T]) Iterator() *Iterator[T] {
+	return o.iterator(func() (T, bool) {
+		return o.Min()
+	}, "")
+}
+
+// IteratorAfter returns a fail-fast Iterator over the elements of OrderedSet
+// which are greater than the element of Cursor c, as OrderedSet.Iterator
+// does. Resuming takes O(log n). The empty Cursor starts from the first
+// element.
+func (o *OrderedSet[T]) IteratorAfter(c Cursor) (*Iterator[T], error) {
+	if c == "" {
+		return o.Iterator(), nil
+	}
+	after, err := decodeCursor[T](c)
+	if err != nil {
+		return nil, err
+	}
+	return o.iterator(func() (T, bool) {
+		return o.higher(after)
+	}, c), nil
+}
+
+// iterator returns a fail-fast Iterator starting with the element returned
+// by first.
+func (o *OrderedSet[T]) iterator(first func() (T, bool), start Cursor) *Iterator[T] {
+	version := o.version
+	it := &Iterator[T]{start: start}
+	it.next = func(after T, started bool) (T, bool, error) {
+		if o.version != version {
+			var zero T
+			return zero, false, &ModifiedError{Returned: it.n}
+		}
+		if !started {
+			v, ok := first()
+			return v, ok, nil
+		}
+		v, ok := o.higher(after)
+		return v, ok, nil
+	}
+	return it
+}
+
+// Iterator returns an Iterator over the elements of Roaring in ascending
+// order. It does not copy the Roaring, each call to Next takes O(log n) to
+// find the element after the last one returned, so the changes of the
+// Roaring after that element are reflected.
+func (r *Roaring[T]) Iterator() *Iterator[T] {
+	return r.iterator(func() (T, bool) {
+		return r.ceiling(0)
+	}, "")
+}
+
+// IteratorAfter returns an Iterator over the elements of Roaring which are
+// greater than the element of Cursor c, as Roaring.Iterator does. Resuming
+// takes O(log n). The empty Cursor starts from the first element.
+func (r *Roaring[T]) IteratorAfter(c Cursor) (*Iterator[T], error) {
+	if c == "" {
+		return r.Iterator(), nil
+	}
+	after, err := decodeCursor[T](c)
+	if err != nil {
+		return nil, err
+	}
+	return r.iterator(func() (T, bool) {
+		return r.higher(after)
+	}, c), nil
+}
+
+// iterator returns an Iterator starting with the element returned by first.
+func (r *Roaring[T]) iterator(first func() (T, bool), start Cursor) *Iterator[T] {
+	return &Iterator[T]{
+		next: func(after T, started bool) (T, bool, error) {
+			if !started {
+				v, ok := first()
+				return v, ok, nil
+			}
+			v, ok := r.higher(after)
+			return v, ok, nil
+		},
+		start: start,
+	}
+}
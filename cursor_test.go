@@ -0,0 +1,334 @@
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package set
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+// pages traverses the set by pages of size n, resuming each page from the
+// Cursor of the previous one as a stateless server would.
+func pages[T comparable](t *testing.T, after func(c Cursor) (*Iterator[T], error), n int, between func()) [][]T {
+	t.Helper()
+	var dest [][]T
+	var c Cursor
+	for {
+		it, err := after(c)
+		if err != nil {
+			t.Fatalf("expect no error, but got: %v", err)
+		}
+		var page []T
+		for len(page) < n && it.Next() {
+			page = append(page, it.Value())
+		}
+		if err := it.Err(); err != nil {
+			t.Fatalf("expect no error, but got: %v", err)
+		}
+		if len(page) == 0 {
+			return dest
+		}
+		dest = append(dest, page)
+		if c, err = it.Cursor(); err != nil {
+			t.Fatalf("expect no error, but got: %v", err)
+		}
+		if between != nil {
+			between()
+		}
+	}
+}
+
+func TestSet_IteratorAfter(t *testing.T) {
+	s := NewUint64(9, 3, 7, 1, 5, 2, 8)
+	got := pages(t, s.IteratorAfter, 3, nil)
+	expect := [][]uint64{{1, 2, 3}, {5, 7, 8}, {9}}
+	if !reflect.DeepEqual(got, expect) {
+		t.Errorf("expect pages: %v, but got: %v", expect, got)
+	}
+
+	// changes between pages: the elements after the cursor reflect the Set
+	// at the time of each page
+	s = NewUint64(1, 2, 3, 4, 5, 6)
+	page := 0
+	got = pages(t, s.IteratorAfter, 2, func() {
+		page++
+		if page == 1 {
+			s.Remove(1, 4)
+			s.Add(0, 10)
+		}
+	})
+	expect = [][]uint64{{1, 2}, {3, 5}, {6, 10}}
+	if !reflect.DeepEqual(got, expect) {
+		t.Errorf("expect pages: %v, but got: %v", expect, got)
+	}
+
+	str := NewString("b", "a", "c")
+	gotStrings := pages(t, str.IteratorAfter, 2, nil)
+	if !reflect.DeepEqual(gotStrings, [][]string{{"a", "b"}, {"c"}}) {
+		t.Errorf("expect pages: [[a b] [c]], but got: %v", gotStrings)
+	}
+
+	f := NewFloat64(1, math.NaN(), math.Inf(-1), -0.5)
+	gotFloats := pages(t, f.IteratorAfter, 1, nil)
+	if len(gotFloats) != 4 || !math.IsNaN(gotFloats[0][0]) || gotFloats[1][0] != math.Inf(-1) || gotFloats[3][0] != 1 {
+		t.Errorf("expect pages: [[NaN] [-Inf] [-0.5] [1]], but got: %v", gotFloats)
+	}
+}
+
+func TestSet_IteratorSnapshot(t *testing.T) {
+	s := NewInt(3, 1, 2)
+	it := s.Iterator()
+	if c, err := it.Cursor(); c != "" || err != nil {
+		t.Errorf("expect empty cursor before the first element, but got: %q, %v", c, err)
+	}
+	s.Add(0, 4)
+	s.Remove(2)
+	var got []int
+	for it.Next() {
+		got = append(got, it.Value())
+	}
+	if !reflect.DeepEqual(got, []int{1, 2, 3}) || it.Err() != nil {
+		t.Errorf("expect snapshot: [1 2 3], but got: %v, %v", got, it.Err())
+	}
+	if it.Next() || it.Value() != 3 {
+		t.Errorf("expect exhausted iterator to keep the last element, but got: %d", it.Value())
+	}
+
+	// the cursor of an exhausted iterator resumes after the last element
+	c, err := it.Cursor()
+	if err != nil {
+		t.Fatalf("expect no error, but got: %v", err)
+	}
+	it, err = s.IteratorAfter(c)
+	if err != nil {
+		t.Fatalf("expect no error, but got: %v", err)
+	}
+	if resumed, _ := it.Cursor(); resumed != c {
+		t.Errorf("expect cursor: %q, but got: %q", c, resumed)
+	}
+	got = got[:0]
+	for it.Next() {
+		got = append(got, it.Value())
+	}
+	if !reflect.DeepEqual(got, []int{4}) {
+		t.Errorf("expect resumed elements: [4], but got: %v", got)
+	}
+
+	sync := NewSync(3, 1, 2)
+	got = nil
+	for it := sync.Iterator(); it.Next(); {
+		got = append(got, it.Value())
+		sync.Add(it.Value() + 10)
+	}
+	if !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("expect snapshot: [1 2 3], but got: %v", got)
+	}
+	if got := pages(t, sync.IteratorAfter, 4, nil); !reflect.DeepEqual(got, [][]int{{1, 2, 3, 11}, {12, 13}}) {
+		t.Errorf("expect pages: [[1 2 3 11] [12 13]], but got: %v", got)
+	}
+}
+
+func TestOrderedSet_Iterator(t *testing.T) {
+	o := NewOrderedSet[uint64]()
+	for i := uint64(0); i < 100; i++ {
+		o.Add(i * 2)
+	}
+	got := pages(t, o.IteratorAfter, 30, nil)
+	if len(got) != 4 || len(got[3]) != 10 || got[1][0] != 60 || got[3][9] != 198 {
+		t.Errorf("expect 4 pages from 0 to 198, but got: %v", got)
+	}
+
+	// changes between pages are allowed, each page starts a new iterator
+	page := 0
+	got = pages(t, NewOrderedSet[uint64](1, 2, 3, 4, 5).IteratorAfter, 2, nil)
+	if !reflect.DeepEqual(got, [][]uint64{{1, 2}, {3, 4}, {5}}) {
+		t.Errorf("expect pages: [[1 2] [3 4] [5]], but got: %v", got)
+	}
+	o = NewOrderedSet[uint64](1, 2, 3, 4, 5)
+	got = pages(t, o.IteratorAfter, 2, func() {
+		if page++; page == 1 {
+			o.Remove(3)
+			o.Add(6)
+		}
+	})
+	if !reflect.DeepEqual(got, [][]uint64{{1, 2}, {4, 5}, {6}}) {
+		t.Errorf("expect pages: [[1 2] [4 5] [6]], but got: %v", got)
+	}
+}
+
+func TestRoaring_Iterator(t *testing.T) {
+	// an array, a bitmap and a run container, then the greatest element
+	r := NewRoaring[uint64](1, 5, 1<<16-1)
+	for i := uint64(0); i < 5000; i++ {
+		r.Add(1<<16 + i*3)
+	}
+	for i := uint64(0); i < 100; i++ {
+		r.Add(5<<16 + i)
+	}
+	r.Add(1<<64 - 1)
+	r.RunOptimize()
+	expect := r.List()
+	for _, n := range []int{1, 7, 1000, len(expect) + 1} {
+		var got []uint64
+		for _, page := range pages(t, r.IteratorAfter, n, nil) {
+			got = append(got, page...)
+		}
+		if !reflect.DeepEqual(got, expect) {
+			t.Errorf("expect pages of %d to traverse %d elements, but got: %d", n, len(expect), len(got))
+		}
+	}
+
+	i32 := NewRoaring[int32](3, -1<<31, -2, 1<<31-1)
+	got := pages(t, i32.IteratorAfter, 3, nil)
+	if !reflect.DeepEqual(got, [][]int32{{-1 << 31, -2, 3}, {1<<31 - 1}}) {
+		t.Errorf("expect pages in ascending order, but got: %v", got)
+	}
+
+	// the changes after the last element returned are reflected
+	r = NewRoaring[uint64](1, 2, 3, 4, 5)
+	page := 0
+	got64 := pages(t, r.IteratorAfter, 2, func() {
+		if page++; page == 1 {
+			r.Remove(3)
+			r.Add(6, 1<<20)
+		}
+	})
+	if !reflect.DeepEqual(got64, [][]uint64{{1, 2}, {4, 5}, {6, 1 << 20}}) {
+		t.Errorf("expect pages: [[1 2] [4 5] [6 1048576]], but got: %v", got64)
+	}
+	it := r.Iterator()
+	it.Next()
+	r.Remove(2)
+	r.Add(0, 3)
+	var rest []uint64
+	for it.Next() {
+		rest = append(rest, it.Value())
+	}
+	if !reflect.DeepEqual(rest, []uint64{3, 4, 5, 6, 1 << 20}) {
+		t.Errorf("expect: [3 4 5 6 1048576], but got: %v", rest)
+	}
+	if it := NewRoaring[uint32]().Iterator(); it.Next() || it.Err() != nil {
+		t.Errorf("expect an empty Iterator")
+	}
+}
+
+func TestOrderedSet_IteratorFailFast(t *testing.T) {
+	other := NewOrderedSet(9)
+	testcases := []struct {
+		name   string
+		modify func(o *OrderedSet[int])
+		fail   bool
+	}{
+		{name: "add present element", modify: func(o *OrderedSet[int]) { o.Add(2) }, fail: false},
+		{name: "remove absent element", modify: func(o *OrderedSet[int]) { o.Remove(7) }, fail: false},
+		{name: "add", modify: func(o *OrderedSet[int]) { o.Add(7) }, fail: true},
+		{name: "remove", modify: func(o *OrderedSet[int]) { o.Remove(1) }, fail: true},
+		{name: "pop", modify: func(o *OrderedSet[int]) { o.Pop() }, fail: true},
+		{name: "clear", modify: func(o *OrderedSet[int]) { o.Clear() }, fail: true},
+		{name: "union with", modify: func(o *OrderedSet[int]) { o.UnionWith(other) }, fail: true},
+		{name: "intersect with", modify: func(o *OrderedSet[int]) { o.IntersectWith(other) }, fail: true},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		o := NewOrderedSet(1, 2, 3)
+		it := o.Iterator()
+		if !it.Next() || it.Value() != 1 {
+			t.Fatalf("expect first element: 1, but got: %d", it.Value())
+		}
+		tc.modify(o)
+		more := it.Next()
+		var modified *ModifiedError
+		if tc.fail {
+			if more || !errors.As(it.Err(), &modified) || modified.Returned != 1 {
+				t.Errorf("expect *ModifiedError after 1 element, but got: %v", it.Err())
+			}
+			if it.Next() {
+				t.Errorf("expect the iterator to stay stopped")
+			}
+			continue
+		}
+		if !more || it.Value() != 2 || it.Err() != nil {
+			t.Errorf("expect next element: 2, but got: %d, %v", it.Value(), it.Err())
+		}
+	}
+
+	// the cursor of a failed iterator resumes on the modified set
+	o := NewOrderedSet(1, 2, 3)
+	it := o.Iterator()
+	it.Next()
+	o.Remove(2)
+	it.Next()
+	c, err := it.Cursor()
+	if err != nil {
+		t.Fatalf("expect no error, but got: %v", err)
+	}
+	if it, err = o.IteratorAfter(c); err != nil || !it.Next() || it.Value() != 3 {
+		t.Errorf("expect resumed element: 3, but got: %d, %v", it.Value(), err)
+	}
+}
+
+func TestCursor_Invalid(t *testing.T) {
+	s := NewInt(1, 2)
+	testcases := []struct {
+		name   string
+		cursor Cursor
+	}{
+		{name: "not base64", cursor: "!!"},
+		{name: "empty data", cursor: "AA"},
+		{name: "unknown version", cursor: "Ag"},
+		{name: "truncated", cursor: "AQE"},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		if _, err := s.IteratorAfter(tc.cursor); !errors.Is(err, ErrInvalidEncoding) {
+			t.Errorf("expect error: %v, but got: %v", ErrInvalidEncoding, err)
+		}
+		if _, err := NewOrderedSet(1).IteratorAfter(tc.cursor); !errors.Is(err, ErrInvalidEncoding) {
+			t.Errorf("expect error: %v, but got: %v", ErrInvalidEncoding, err)
+		}
+		if _, err := NewRoaring[int32](1).IteratorAfter(tc.cursor); !errors.Is(err, ErrInvalidEncoding) {
+			t.Errorf("expect error: %v, but got: %v", ErrInvalidEncoding, err)
+		}
+	}
+
+	it := NewString("a").Iterator()
+	it.Next()
+	c, err := it.Cursor()
+	if err != nil {
+		t.Fatalf("expect no error, but got: %v", err)
+	}
+	if _, err := s.IteratorAfter(c); err == nil {
+		t.Errorf("expect error for a cursor of another element type")
+	}
+
+	i := NewInterface(1)
+	ii := i.Iterator()
+	ii.Next()
+	if _, err := ii.Cursor(); err == nil {
+		t.Errorf("expect error for the cursor of an Interface")
+	}
+}
@@ -0,0 +1,370 @@
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package set
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Float is a constraint that permits the floating point types.
+type Float interface {
+	~float32 | ~float64
+}
+
+// FloatOptions configures how a FloatSet compares zeros.
+type FloatOptions struct {
+	// DistinctZeros makes -0 and +0 different elements, by default they
+	// are equal as with ==, and a zero is stored as +0 whatever the sign
+	// it was added with.
+	DistinctZeros bool
+}
+
+// FloatSet is a collection of floats that contains no duplicate elements,
+// without any particular order, with a defined policy for the values which
+// == does not compare as a Set does:
+//   - NaN is a single member: adding NaN twice adds it once, and Has and
+//     Remove find it, whatever its bits. A Set of floats instead stores
+//     every NaN added as a new key which can never be found or removed.
+//   - -0 and +0 are the same member stored as +0, or two members with
+//     FloatOptions.DistinctZeros.
+//
+// The elements are traversed in their natural order, NaN first and -0
+// before +0.
+// The zero value is an empty FloatSet ready to use.
+type FloatSet[T Float] struct {
+	// m holds the members other than NaN and a distinct -0, a zero is
+	// stored as +0.
+	m       map[T]struct{}
+	nan     bool
+	negZero bool
+	opts    FloatOptions
+}
+
+// NewFloatSet initializes a new FloatSet where -0 and +0 are equal.
+func NewFloatSet[T Float](elements ...T) *FloatSet[T] {
+	return NewFloatSetWithOptions(FloatOptions{}, elements...)
+}
+
+// NewFloatSetWithOptions initializes a new FloatSet configured by opts.
+func NewFloatSetWithOptions[T Float](opts FloatOptions, elements ...T) *FloatSet[T] {
+	f := &FloatSet[T]{opts: opts}
+	f.Add(elements...)
+	return f
+}
+
+// NewFloatSetFromSet initializes a new FloatSet with the elements of Set s,
+// the NaNs of s become a single member.
+func NewFloatSetFromSet[T Float](s Set[T]) *FloatSet[T] {
+	f := &FloatSet[T]{m: make(map[T]struct{}, len(s))}
+	for k := range s {
+		f.add(k)
+	}
+	return f
+}
+
+// ToSet returns a Set with the elements of FloatSet, NaN is added once but
+// it cannot be found in the Set.
+func (f *FloatSet[T]) ToSet() Set[T] {
+	s := NewWithSize[T](f.Size())
+	f.Each(func(i T) {
+		s[i] = struct{}{}
+	})
+	return s
+}
+
+// isNegZero reports whether element is a -0 distinct from +0.
+func (f *FloatSet[T]) isNegZero(element T) bool {
+	return f.opts.DistinctZeros && element == 0 && math.Signbit(float64(element))
+}
+
+func (f *FloatSet[T]) add(element T) {
+	switch {
+	case element != element:
+		f.nan = true
+	case f.isNegZero(element):
+		f.negZero = true
+	default:
+		if element == 0 {
+			element = 0
+		}
+		if f.m == nil {
+			f.m = map[T]struct{}{}
+		}
+		f.m[element] = struct{}{}
+	}
+}
+
+// Add adds the elements to FloatSet, if it is not present already.
+func (f *FloatSet[T]) Add(elements ...T) {
+	for _, element := range elements {
+		f.add(element)
+	}
+}
+
+// Remove removes the element from FloatSet, if it is present.
+func (f *FloatSet[T]) Remove(elements ...T) {
+	for _, element := range elements {
+		switch {
+		case element != element:
+			f.nan = false
+		case f.isNegZero(element):
+			f.negZero = false
+		default:
+			delete(f.m, element)
+		}
+	}
+}
+
+// Pop returns the smallest element of FloatSet, deleting it from FloatSet.
+// The second value is a bool that is true if the elements existed in
+// the FloatSet, and false if not.
+func (f *FloatSet[T]) Pop() (T, bool) {
+	v := f.List()
+	if len(v) == 0 {
+		return 0, false
+	}
+	f.Remove(v[0])
+	return v[0], true
+}
+
+// Size returns the number of elements in FloatSet.
+func (f *FloatSet[T]) Size() int {
+	n := len(f.m)
+	if f.nan {
+		n++
+	}
+	if f.negZero {
+		n++
+	}
+	return n
+}
+
+// IsEmpty returns whether the FloatSet is Empty.
+func (f *FloatSet[T]) IsEmpty() bool {
+	return f.Size() == 0
+}
+
+// Clear removes all items from the FloatSet.
+func (f *FloatSet[T]) Clear() {
+	f.m, f.nan, f.negZero = nil, false, false
+}
+
+// Has judges the specified element whether exists in the FloatSet.
+// it returns true if existed, and false if not.
+func (f *FloatSet[T]) Has(element T) bool {
+	switch {
+	case element != element:
+		return f.nan
+	case f.isNegZero(element):
+		return f.negZero
+	default:
+		_, ok := f.m[element]
+		return ok
+	}
+}
+
+// HasAll looks for the specified elements to judge
+// whether all of them exist in the FloatSet.
+func (f *FloatSet[T]) HasAll(elements ...T) bool {
+	for _, element := range elements {
+		if !f.Has(element) {
+			return false
+		}
+	}
+	return true
+}
+
+// HasAny looks for the specified elements to judge
+// whether at least one of them exists in the FloatSet.
+func (f *FloatSet[T]) HasAny(elements ...T) bool {
+	for _, element := range elements {
+		if f.Has(element) {
+			return true
+		}
+	}
+	return false
+}
+
+// List returns the all elements as a slice in their natural order, NaN
+// first and -0 before +0.
+func (f *FloatSet[T]) List() []T {
+	v := make([]T, 0, f.Size())
+	if f.nan {
+		v = append(v, T(math.NaN()))
+	}
+	for k := range f.m {
+		v = append(v, k)
+	}
+	sortOrdered(v)
+	if f.negZero {
+		i := sort.Search(len(v), func(i int) bool {
+			return v[i] >= 0
+		})
+		v = append(v, 0)
+		copy(v[i+1:], v[i:])
+		v[i] = T(math.Copysign(0, -1))
+	}
+	return v
+}
+
+// EachE traverses the elements in the FloatSet in their natural order,
+// calling do func for each FloatSet member. the cycle will be stopped when
+// the do func returns error.
+// if err is ErrBreakEach, break the cycle and return nil,
+// else, break the cycle and return error.
+func (f *FloatSet[T]) EachE(do func(i T) error) error {
+	for _, k := range f.List() {
+		if err := do(k); err != nil {
+			if err == ErrBreakEach {
+				return nil
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// Each traverses the elements in the FloatSet in their natural order,
+// calling do func for each FloatSet member.
+func (f *FloatSet[T]) Each(do func(i T)) {
+	for _, k := range f.List() {
+		do(k)
+	}
+}
+
+// combine returns a new FloatSet which is op of FloatSet f and g, with the
+// options of f.
+func (f *FloatSet[T]) combine(g *FloatSet[T], op int) *FloatSet[T] {
+	if g.opts != f.opts {
+		g = NewFloatSetWithOptions(f.opts, g.List()...)
+	}
+	x, y := Set[T](f.m), Set[T](g.m)
+	u := &FloatSet[T]{opts: f.opts}
+	switch op {
+	case opOr:
+		u.m = x.Union(y)
+	case opAnd:
+		u.m = x.Intersection(y)
+	case opAndNot:
+		u.m = x.Difference(y)
+	case opXor:
+		u.m = x.SymmetricDifference(y)
+	}
+	u.nan = combineBool(f.nan, g.nan, op)
+	u.negZero = combineBool(f.negZero, g.negZero, op)
+	return u
+}
+
+// combineBool returns op of the memberships x and y.
+func combineBool(x, y bool, op int) bool {
+	switch op {
+	case opOr:
+		return x || y
+	case opAnd:
+		return x && y
+	case opAndNot:
+		return x && !y
+	default:
+		return x != y
+	}
+}
+
+// Union returns the union of FloatSet f and g.
+func (f *FloatSet[T]) Union(g *FloatSet[T]) *FloatSet[T] {
+	return f.combine(g, opOr)
+}
+
+// Difference returns the difference of FloatSet f and g.
+func (f *FloatSet[T]) Difference(g *FloatSet[T]) *FloatSet[T] {
+	return f.combine(g, opAndNot)
+}
+
+// Intersection returns the intersection of FloatSet f and g.
+func (f *FloatSet[T]) Intersection(g *FloatSet[T]) *FloatSet[T] {
+	return f.combine(g, opAnd)
+}
+
+// SymmetricDifference returns a new FloatSet with the elements that are either in this FloatSet
+// or in the given FloatSet, but not in both.
+func (f *FloatSet[T]) SymmetricDifference(g *FloatSet[T]) *FloatSet[T] {
+	return f.combine(g, opXor)
+}
+
+// UnionWith adds all elements of FloatSet g to FloatSet f.
+func (f *FloatSet[T]) UnionWith(g *FloatSet[T]) {
+	*f = *f.combine(g, opOr)
+}
+
+// DifferenceWith removes all elements of FloatSet g from FloatSet f.
+func (f *FloatSet[T]) DifferenceWith(g *FloatSet[T]) {
+	*f = *f.combine(g, opAndNot)
+}
+
+// IntersectWith removes the elements of FloatSet f which are not in FloatSet g.
+func (f *FloatSet[T]) IntersectWith(g *FloatSet[T]) {
+	*f = *f.combine(g, opAnd)
+}
+
+// SymmetricDifferenceWith keeps the elements that are either in FloatSet f or
+// in FloatSet g, but not in both.
+func (f *FloatSet[T]) SymmetricDifferenceWith(g *FloatSet[T]) {
+	*f = *f.combine(g, opXor)
+}
+
+// IsSubset predicates that tests whether the FloatSet f is a subset of FloatSet g.
+func (f *FloatSet[T]) IsSubset(g *FloatSet[T]) bool {
+	return f.Difference(g).IsEmpty()
+}
+
+// IsSuperset predicates that tests whether the FloatSet f is a super of FloatSet g.
+func (f *FloatSet[T]) IsSuperset(g *FloatSet[T]) bool {
+	return g.IsSubset(f)
+}
+
+// Equal predicates that tests whether the FloatSet f equals of FloatSet g.
+func (f *FloatSet[T]) Equal(g *FloatSet[T]) bool {
+	return f.SymmetricDifference(g).IsEmpty()
+}
+
+// Copy returns new FloatSet that clones from FloatSet.
+func (f *FloatSet[T]) Copy() *FloatSet[T] {
+	u := *f
+	u.m = Set[T](f.m).Copy()
+	return &u
+}
+
+// String returns a string representation of FloatSet, the elements are in
+// their natural order.
+func (f *FloatSet[T]) String() string {
+	return joinElements(f.List(), "%v")
+}
+
+// Format implements fmt.Formatter, the elements are in their natural order.
+// See Set.Format for the verbs.
+func (f *FloatSet[T]) Format(s fmt.State, verb rune) {
+	formatElements(s, verb, f.List(), "NewFloatSet["+typeName[T]()+"]")
+}
@@ -0,0 +1,389 @@
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package set
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+// floatBits returns the bits of the elements, so that NaN and the sign of
+// zeros are compared.
+func floatBits(v []float64) []uint64 {
+	dest := make([]uint64, len(v))
+	for i, f := range v {
+		if f != f {
+			f = math.NaN()
+		}
+		dest[i] = math.Float64bits(f)
+	}
+	return dest
+}
+
+func validateFloats(t *testing.T, actual, expect []float64) {
+	t.Helper()
+	if fmt.Sprint(floatBits(actual)) != fmt.Sprint(floatBits(expect)) {
+		t.Errorf("expect elements: %v, but got: %v", expect, actual)
+	}
+}
+
+var negZero = math.Copysign(0, -1)
+
+func TestSet_FloatNaN(t *testing.T) {
+	// the behaviour FloatSet exists for
+	s := NewFloat64(math.NaN(), math.NaN())
+	if s.Size() != 2 || s.Has(math.NaN()) {
+		t.Errorf("expect 2 unreachable NaNs, but got: %v", s)
+	}
+}
+
+func TestFloatSet(t *testing.T) {
+	nan := math.NaN()
+	// a NaN with other payload bits
+	otherNaN := math.Float64frombits(0x7ff8000000000123)
+	testcases := []struct {
+		name   string
+		opts   FloatOptions
+		add    []float64
+		remove []float64
+		expect []float64
+		has    []float64
+		hasNot []float64
+	}{
+		{
+			name:   "single NaN",
+			add:    []float64{nan, 1, nan, otherNaN},
+			expect: []float64{nan, 1},
+			has:    []float64{nan, otherNaN, 1},
+		},
+		{
+			name:   "remove NaN",
+			add:    []float64{nan, 1},
+			remove: []float64{otherNaN},
+			expect: []float64{1},
+			hasNot: []float64{nan},
+		},
+		{
+			name:   "unified zeros are stored as +0",
+			add:    []float64{negZero, 0, -1},
+			expect: []float64{-1, 0},
+			has:    []float64{0, negZero},
+		},
+		{
+			name:   "unified zeros remove either",
+			add:    []float64{0},
+			remove: []float64{negZero},
+			expect: []float64{},
+			hasNot: []float64{0, negZero},
+		},
+		{
+			name:   "distinct zeros",
+			opts:   FloatOptions{DistinctZeros: true},
+			add:    []float64{1, 0, negZero, -1, nan, negZero},
+			expect: []float64{nan, -1, negZero, 0, 1},
+			has:    []float64{0, negZero},
+		},
+		{
+			name:   "distinct zeros remove -0",
+			opts:   FloatOptions{DistinctZeros: true},
+			add:    []float64{0, negZero},
+			remove: []float64{negZero},
+			expect: []float64{0},
+			has:    []float64{0},
+			hasNot: []float64{negZero},
+		},
+		{
+			name:   "distinct zeros remove +0",
+			opts:   FloatOptions{DistinctZeros: true},
+			add:    []float64{0, negZero},
+			remove: []float64{0},
+			expect: []float64{negZero},
+			has:    []float64{negZero},
+			hasNot: []float64{0},
+		},
+		{
+			name:   "infinities",
+			add:    []float64{math.Inf(1), math.Inf(-1), math.Inf(1)},
+			expect: []float64{math.Inf(-1), math.Inf(1)},
+			has:    []float64{math.Inf(1)},
+		},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		f := NewFloatSetWithOptions(tc.opts, tc.add...)
+		f.Remove(tc.remove...)
+		validateFloats(t, f.List(), tc.expect)
+		if f.Size() != len(tc.expect) {
+			t.Errorf("expect size: %d, but got: %d", len(tc.expect), f.Size())
+		}
+		for _, x := range tc.has {
+			if !f.Has(x) {
+				t.Errorf("expect %v in %v", x, f)
+			}
+		}
+		for _, x := range tc.hasNot {
+			if f.Has(x) {
+				t.Errorf("expect %v not in %v", x, f)
+			}
+		}
+	}
+}
+
+func TestFloatSet_Operations(t *testing.T) {
+	nan := math.NaN()
+	distinct := FloatOptions{DistinctZeros: true}
+	testcases := []struct {
+		name   string
+		f, g   *FloatSet[float64]
+		op     func(f, g *FloatSet[float64]) *FloatSet[float64]
+		expect []float64
+	}{
+		{
+			name:   "union merges NaN",
+			f:      NewFloatSet(nan, 1),
+			g:      NewFloatSet(nan, 2),
+			op:     (*FloatSet[float64]).Union,
+			expect: []float64{nan, 1, 2},
+		},
+		{
+			name:   "intersection keeps NaN",
+			f:      NewFloatSet(nan, 1),
+			g:      NewFloatSet(nan, 2),
+			op:     (*FloatSet[float64]).Intersection,
+			expect: []float64{nan},
+		},
+		{
+			name:   "difference removes NaN",
+			f:      NewFloatSet(nan, 1),
+			g:      NewFloatSet(nan),
+			op:     (*FloatSet[float64]).Difference,
+			expect: []float64{1},
+		},
+		{
+			name:   "symmetric difference of zeros",
+			f:      NewFloatSetWithOptions(distinct, negZero, 1),
+			g:      NewFloatSetWithOptions[float64](distinct, 0, 1),
+			op:     (*FloatSet[float64]).SymmetricDifference,
+			expect: []float64{negZero, 0},
+		},
+		{
+			name:   "options of the receiver apply",
+			f:      NewFloatSet[float64](0),
+			g:      NewFloatSetWithOptions(distinct, negZero),
+			op:     (*FloatSet[float64]).Difference,
+			expect: []float64{},
+		},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		validateFloats(t, tc.op(tc.f, tc.g).List(), tc.expect)
+	}
+
+	f := NewFloatSet(nan, 1)
+	f.UnionWith(NewFloatSet[float64](2))
+	f.DifferenceWith(NewFloatSet[float64](1))
+	validateFloats(t, f.List(), []float64{nan, 2})
+	if !f.Equal(NewFloatSet(2, nan)) || !f.IsSuperset(NewFloatSet(nan)) || f.IsSubset(NewFloatSet[float64](2)) {
+		t.Errorf("expect equal to [NaN, 2], but got: %v", f)
+	}
+	c := f.Copy()
+	c.Remove(nan)
+	if !f.Has(nan) {
+		t.Errorf("expect the copy to be independent")
+	}
+	if x, ok := f.Pop(); !ok || x == x {
+		t.Errorf("expect to pop NaN first, but got: %v, %v", x, ok)
+	}
+
+	var zero FloatSet[float32]
+	zero.Add(float32(math.NaN()), 1)
+	if zero.Size() != 2 || zero.String() != "[NaN, 1]" {
+		t.Errorf("expect the zero value to be usable, but got: %v", &zero)
+	}
+
+	fromSet := NewFloatSetFromSet(NewFloat64(nan, nan, 1))
+	validateFloats(t, fromSet.List(), []float64{nan, 1})
+	if got := fmt.Sprintf("%#v", fromSet); got != "set.NewFloatSet[float64](math.NaN(), 1)" {
+		t.Errorf("expect go syntax, but got: %s", got)
+	}
+}
+
+func TestApproxSet(t *testing.T) {
+	nan := math.NaN()
+	testcases := []struct {
+		name    string
+		epsilon float64
+		add     []float64
+		remove  []float64
+		expect  []float64
+		has     []float64
+		hasNot  []float64
+	}{
+		{
+			name:    "within epsilon",
+			epsilon: 0.01,
+			add:     []float64{0.1 + 0.2, 0.3, 0.305, 0.32},
+			expect:  []float64{0.1 + 0.2, 0.32},
+			has:     []float64{0.3, 0.309, 0.291, 0.329},
+			hasNot:  []float64{0.2899, 0.3301},
+		},
+		{
+			name:    "bucket boundaries",
+			epsilon: 1,
+			add:     []float64{0.99, 1.5},
+			expect:  []float64{0.99},
+			has:     []float64{-0.01, 1.99},
+			hasNot:  []float64{-0.02, 2},
+		},
+		{
+			name:    "not transitive",
+			epsilon: 1,
+			add:     []float64{0, 0.8, 1.6},
+			expect:  []float64{0, 1.6},
+		},
+		{
+			name:    "remove both neighbours",
+			epsilon: 1,
+			add:     []float64{0, 1.6},
+			remove:  []float64{0.8},
+			expect:  []float64{},
+		},
+		{
+			name:    "NaN and zeros",
+			epsilon: 0.5,
+			add:     []float64{nan, nan, negZero, 0.25},
+			remove:  []float64{},
+			expect:  []float64{nan, negZero},
+			has:     []float64{nan, 0},
+		},
+		{
+			name:    "remove NaN",
+			epsilon: 0.5,
+			add:     []float64{nan, 1},
+			remove:  []float64{nan},
+			expect:  []float64{1},
+			hasNot:  []float64{nan},
+		},
+		{
+			name:    "infinities and extremes",
+			epsilon: 1e-300,
+			add:     []float64{math.Inf(1), math.MaxFloat64, math.Inf(-1), -math.MaxFloat64, math.Inf(1)},
+			expect:  []float64{math.Inf(-1), -math.MaxFloat64, math.MaxFloat64, math.Inf(1)},
+			has:     []float64{math.Inf(1), math.MaxFloat64},
+		},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		a := NewApproxFloat64(tc.epsilon, tc.add...)
+		a.Remove(tc.remove...)
+		validateFloats(t, a.List(), tc.expect)
+		if a.Size() != len(tc.expect) {
+			t.Errorf("expect size: %d, but got: %d", len(tc.expect), a.Size())
+		}
+		for _, x := range tc.has {
+			if !a.Has(x) {
+				t.Errorf("expect %v in %v", x, a)
+			}
+		}
+		for _, x := range tc.hasNot {
+			if a.Has(x) {
+				t.Errorf("expect %v not in %v", x, a)
+			}
+		}
+	}
+
+	a := NewApproxFloat32(0.5, 1, 2)
+	if got := fmt.Sprintf("%#v", a); got != "set.NewApproxSet[float32](0.5, 1, 2)" {
+		t.Errorf("expect go syntax with the epsilon, but got: %s", got)
+	}
+	a.Clear()
+	if !a.IsEmpty() || a.Has(1) {
+		t.Errorf("expect empty set, but got: %v", a)
+	}
+
+	for _, epsilon := range []float64{0, -1, nan, math.Inf(1)} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expect panic for epsilon: %v", epsilon)
+				}
+			}()
+			NewApproxFloat64(epsilon)
+		}()
+	}
+}
+
+func TestApproxSet_Zero(t *testing.T) {
+	var a ApproxFloat64
+	a.Add(1, 1, negZero, 0, math.NaN(), math.NaN(), 1.0000001)
+	validateFloats(t, a.List(), []float64{math.NaN(), negZero, 1, 1.0000001})
+	if a.Size() != 4 || !a.Has(0) || a.Has(1.00000001) || a.Epsilon() != 0 {
+		t.Errorf("expect the zero value to compare with ==, but got: %v", &a)
+	}
+	a.Remove(0, 1)
+	validateFloats(t, a.List(), []float64{math.NaN(), 1.0000001})
+
+	var b ApproxFloat64
+	b.Remove(1)
+	if b.Has(1) || !b.IsEmpty() || b.Copy().Size() != 0 {
+		t.Errorf("expect an empty set, but got: %v", &b)
+	}
+}
+
+func TestApproxSet_Operations(t *testing.T) {
+	nan := math.NaN()
+	a := NewApproxFloat64(0.1, nan, 1, 2, 3)
+	b := NewApproxFloat64(0.1, 1.05, 2.5, 3.2)
+	testcases := []struct {
+		name   string
+		result *ApproxFloat64
+		expect []float64
+	}{
+		{name: "union", result: a.Union(b), expect: []float64{nan, 1, 2, 2.5, 3, 3.2}},
+		{name: "union keeps the receiver", result: b.Union(a), expect: []float64{nan, 1.05, 2, 2.5, 3, 3.2}},
+		{name: "difference", result: a.Difference(b), expect: []float64{nan, 2, 3}},
+		{name: "intersection", result: a.Intersection(b), expect: []float64{1}},
+		{name: "intersection keeps the receiver", result: b.Intersection(a), expect: []float64{1.05}},
+		{name: "symmetric difference", result: a.SymmetricDifference(b), expect: []float64{nan, 2, 2.5, 3, 3.2}},
+		{name: "epsilon of the argument", result: a.Intersection(NewApproxFloat64(1, 2.5)), expect: []float64{2, 3}},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		validateFloats(t, tc.result.List(), tc.expect)
+		if tc.result.Size() != len(tc.expect) || tc.result.Epsilon() != 0.1 {
+			t.Errorf("expect size: %d and epsilon: 0.1, but got: %d and %v", len(tc.expect), tc.result.Size(), tc.result.Epsilon())
+		}
+	}
+
+	c := a.Copy()
+	c.Add(5)
+	c.Remove(1)
+	validateFloats(t, a.List(), []float64{nan, 1, 2, 3})
+	validateFloats(t, c.List(), []float64{nan, 2, 3, 5})
+	if c.Epsilon() != 0.1 || !c.Has(2.05) {
+		t.Errorf("expect the copy to keep epsilon: 0.1, but got: %v", c.Epsilon())
+	}
+}
@@ -0,0 +1,171 @@
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package set
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"strings"
+)
+
+// names maps the element types of the named sets in types.go to their names.
+var names = map[reflect.Type]string{
+	reflect.TypeOf((*interface{})(nil)).Elem(): "Interface",
+	reflect.TypeOf(""):                         "String",
+	reflect.TypeOf(int(0)):                     "Int",
+	reflect.TypeOf(int8(0)):                    "Int8",
+	reflect.TypeOf(int16(0)):                   "Int16",
+	reflect.TypeOf(int32(0)):                   "Int32",
+	reflect.TypeOf(int64(0)):                   "Int64",
+	reflect.TypeOf(float32(0)):                 "Float32",
+	reflect.TypeOf(float64(0)):                 "Float64",
+	reflect.TypeOf(uint(0)):                    "Uint",
+	reflect.TypeOf(uint8(0)):                   "Uint8",
+	reflect.TypeOf(uint16(0)):                  "Uint16",
+	reflect.TypeOf(uint32(0)):                  "Uint32",
+	reflect.TypeOf(uint64(0)):                  "Uint64",
+	reflect.TypeOf(uintptr(0)):                 "Uintptr",
+}
+
+// typeName returns the Go syntax of type T.
+func typeName[T any]() string {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	if t.Kind() == reflect.Interface && t.NumMethod() == 0 && t.Name() == "" {
+		return "interface{}"
+	}
+	return t.String()
+}
+
+// joinElements formats the elements with format, joined by ", " inside
+// brackets, which is the representation returned by String.
+func joinElements[T any](elements []T, format string) string {
+	v := make([]string, len(elements))
+	for i, element := range elements {
+		v[i] = fmt.Sprintf(format, element)
+	}
+	return fmt.Sprintf("[%s]", strings.Join(v, ", "))
+}
+
+// formatElements implements fmt.Formatter for the sets, given the elements
+// in the order they are displayed and the name of the constructor of the set
+// type, such as NewInt or NewSync[int]:
+//   - %v formats the elements as String does, %+v adds the size of the set,
+//   - %#v formats a Go expression which creates the set, such as
+//     set.NewInt(1, 2),
+//   - %s formats the output of String with the flags of the verb, as it was
+//     done before the sets implemented fmt.Formatter,
+//   - the other verbs, such as %q or %x, are applied to every element, as
+//     fmt does for slices.
+func formatElements[T any](f fmt.State, verb rune, elements []T, constructor string) {
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		v := make([]string, len(elements))
+		for i := range elements {
+			v[i] = goSyntax(reflect.ValueOf(&elements[i]).Elem())
+		}
+		fmt.Fprintf(f, "set.%s(%s)", constructor, strings.Join(v, ", "))
+	case verb == 's':
+		fmt.Fprintf(f, fmt.FormatString(f, verb), joinElements(elements, "%v"))
+	case verb == 'v' && f.Flag('+'):
+		fmt.Fprintf(f, "%s (size: %d)", joinElements(elements, fmt.FormatString(f, verb)), len(elements))
+	default:
+		io.WriteString(f, joinElements(elements, fmt.FormatString(f, verb)))
+	}
+}
+
+// goSyntax returns a Go expression of the value v. Floats which have no
+// literal are written as calls of package math, and the type of a value held
+// by an interface is spelled out unless it is the default type of its
+// literal, so that the expression evaluates to an equal element.
+func goSyntax(v reflect.Value) string {
+	typed := false
+	if v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return "nil"
+		}
+		v, typed = v.Elem(), true
+	}
+	s := fmt.Sprintf("%#v", v.Interface())
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		switch f := v.Float(); {
+		case math.IsNaN(f):
+			s = "math.NaN()"
+		case math.IsInf(f, 1):
+			s = "math.Inf(1)"
+		case math.IsInf(f, -1):
+			s = "math.Inf(-1)"
+		}
+		// math returns float64, which is not assignable to other float types
+		typed = typed || (strings.HasPrefix(s, "math.") && v.Type() != reflect.TypeOf(float64(0)))
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+	default:
+		// composite literals name their type already
+		return s
+	}
+	switch v.Type() {
+	case reflect.TypeOf(false), reflect.TypeOf(""), reflect.TypeOf(0):
+		return s
+	}
+	if typed {
+		return fmt.Sprintf("%s(%s)", v.Type(), s)
+	}
+	return s
+}
+
+// Format implements fmt.Formatter, the elements are in their natural order.
+// See formatElements for the verbs.
+// For example:
+//
+//	fmt.Sprintf("%v", NewInt(2, 1))  // [1, 2]
+//	fmt.Sprintf("%+v", NewInt(2, 1)) // [1, 2] (size: 2)
+//	fmt.Sprintf("%#v", NewInt(2, 1)) // set.NewInt(1, 2)
+//	fmt.Sprintf("%q", NewString("b", "a")) // ["a", "b"]
+func (s Set[T]) Format(f fmt.State, verb rune) {
+	v := s.List()
+	sortElements(v)
+	if s == nil && verb == 'v' && f.Flag('#') {
+		fmt.Fprintf(f, "set.%s(nil)", setName[T]())
+		return
+	}
+	constructor := "New[" + typeName[T]() + "]"
+	if name, ok := names[reflect.TypeOf((*T)(nil)).Elem()]; ok {
+		constructor = "New" + name
+	}
+	formatElements(f, verb, v, constructor)
+}
+
+// setName returns the name of Set[T], which is the named set of T if there
+// is one.
+func setName[T comparable]() string {
+	if name, ok := names[reflect.TypeOf((*T)(nil)).Elem()]; ok {
+		return name
+	}
+	return "Set[" + typeName[T]() + "]"
+}
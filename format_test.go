@@ -0,0 +1,109 @@
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package set
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+type formatPoint struct {
+	X, Y int
+}
+
+type formatID int
+
+func TestSet_String(t *testing.T) {
+	testcases := []struct {
+		name   string
+		set    fmt.Stringer
+		expect string
+	}{
+		{name: "empty", set: NewInt(), expect: "[]"},
+		{name: "nil", set: Int(nil), expect: "[]"},
+		{name: "int", set: NewInt(3, -1, 20, 2), expect: "[-1, 2, 3, 20]"},
+		{name: "uint8", set: NewUint8(255, 0, 7), expect: "[0, 7, 255]"},
+		{name: "float64", set: NewFloat64(1.5, math.Inf(-1), math.NaN(), 0), expect: "[NaN, -Inf, 0, 1.5]"},
+		{name: "string", set: NewString("b", "", "a", "ab"), expect: "[, a, ab, b]"},
+		{name: "interface", set: NewInterface("b", 2, nil, 1, true, "a", 1.5), expect: "[<nil>, true, 1.5, 1, 2, a, b]"},
+		{name: "struct", set: New(formatPoint{2, 1}, formatPoint{1, 2}, formatPoint{1, 1}), expect: "[{1 1}, {1 2}, {2 1}]"},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		for i := 0; i < 10; i++ {
+			if actual := tc.set.String(); actual != tc.expect {
+				t.Fatalf("expect string: %s, but got: %s", tc.expect, actual)
+			}
+		}
+	}
+}
+
+func TestSet_Format(t *testing.T) {
+	testcases := []struct {
+		name   string
+		format string
+		set    interface{}
+		expect string
+	}{
+		{name: "v", format: "%v", set: NewInt(2, 1), expect: "[1, 2]"},
+		{name: "plus v", format: "%+v", set: NewInt(2, 1), expect: "[1, 2] (size: 2)"},
+		{name: "plus v struct", format: "%+v", set: New(formatPoint{1, 2}), expect: "[{X:1 Y:2}] (size: 1)"},
+		{name: "sharp v", format: "%#v", set: NewInt(2, 1), expect: "set.NewInt(1, 2)"},
+		{name: "sharp v empty", format: "%#v", set: NewString(), expect: "set.NewString()"},
+		{name: "sharp v nil", format: "%#v", set: Int(nil), expect: "set.Int(nil)"},
+		{name: "sharp v nil generic", format: "%#v", set: Set[formatID](nil), expect: "set.Set[set.formatID](nil)"},
+		{name: "sharp v string", format: "%#v", set: NewString("b", "a"), expect: `set.NewString("a", "b")`},
+		{name: "sharp v uint8", format: "%#v", set: NewUint8(16, 1), expect: "set.NewUint8(0x1, 0x10)"},
+		{name: "sharp v float32", format: "%#v", set: NewFloat32(1.5, float32(math.NaN()), float32(math.Inf(1))), expect: "set.NewFloat32(float32(math.NaN()), 1.5, float32(math.Inf(1)))"},
+		{name: "sharp v float64", format: "%#v", set: NewFloat64(2, math.Inf(-1)), expect: "set.NewFloat64(math.Inf(-1), 2)"},
+		{name: "sharp v struct", format: "%#v", set: New(formatPoint{1, 2}), expect: "set.New[set.formatPoint](set.formatPoint{X:1, Y:2})"},
+		{name: "sharp v named", format: "%#v", set: New[formatID](2, 1), expect: "set.New[set.formatID](1, 2)"},
+		{name: "sharp v interface", format: "%#v", set: NewInterface(nil, 1, "a", int8(2), 1.5, formatID(3), true), expect: `set.NewInterface(nil, true, float64(1.5), 1, int8(2), set.formatID(3), "a")`},
+		{name: "q", format: "%q", set: NewString("b", "a"), expect: `["a", "b"]`},
+		{name: "x", format: "%x", set: NewInt(255, 16), expect: "[10, ff]"},
+		{name: "width", format: "%3d", set: NewInt(2, 1), expect: "[  1,   2]"},
+		{name: "precision", format: "%.1f", set: NewFloat64(0.25, 1), expect: "[0.2, 1.0]"},
+		{name: "s", format: "%s", set: NewInt(2, 1), expect: "[1, 2]"},
+		{name: "s width", format: "%-8s|", set: NewInt(2, 1), expect: "[1, 2]  |"},
+		{name: "sync", format: "%#v", set: NewSync(2, 1), expect: "set.NewSync[int](1, 2)"},
+		{name: "sync plus v", format: "%+v", set: NewSync("b", "a"), expect: "[a, b] (size: 2)"},
+		{name: "sharded", format: "%#v", set: NewSharded[uint64](2, 1), expect: "set.NewSharded[uint64](0x1, 0x2)"},
+		{name: "sharded v", format: "%v", set: NewSharded(3, 1, 2), expect: "[1, 2, 3]"},
+		{name: "bitset", format: "%#v", set: NewBitset[int8](2, -1), expect: "set.NewBitset[int8](-1, 2)"},
+		{name: "roaring", format: "%d", set: NewRoaring[int32](2, -1), expect: "[-1, 2]"},
+		{name: "ordered set", format: "%+v", set: NewOrderedSet("b", "a"), expect: "[a, b] (size: 2)"},
+		{name: "linked set", format: "%#v", set: NewLinkedSet("b", "a"), expect: `set.NewLinkedSet[string]("b", "a")`},
+		{name: "structural set", format: "%#v", set: MustNewStructuralSet([]int{1, 2}, "a"), expect: `set.MustNewStructuralSet([]int{1, 2}, "a")`},
+		{name: "structural set empty", format: "%#v", set: &StructuralSet{}, expect: "set.MustNewStructuralSet()"},
+		{name: "linked set interface", format: "%#v", set: NewLinkedSet[interface{}]("b", 1), expect: `set.NewLinkedSet[interface{}]("b", 1)`},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		if actual := fmt.Sprintf(tc.format, tc.set); actual != tc.expect {
+			t.Errorf("expect %s: %s, but got: %s", tc.format, tc.expect, actual)
+		}
+	}
+}
@@ -0,0 +1,226 @@
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package set
+
+// Filter returns a new Set with the elements of Set s for which pred
+// returns true.
+// For example:
+// s = {1, 2, 3, 4}
+// s.Filter(even) = {2, 4}
+func (s Set[T]) Filter(pred func(i T) bool) Set[T] {
+	u := Set[T]{}
+	for k := range s {
+		if pred(k) {
+			u[k] = struct{}{}
+		}
+	}
+	return u
+}
+
+// Partition returns two new sets, the first with the elements of Set s for
+// which pred returns true, the second with the others.
+// For example:
+// s = {1, 2, 3, 4}
+// s.Partition(even) = {2, 4}, {1, 3}
+func (s Set[T]) Partition(pred func(i T) bool) (Set[T], Set[T]) {
+	in, out := Set[T]{}, Set[T]{}
+	for k := range s {
+		if pred(k) {
+			in[k] = struct{}{}
+		} else {
+			out[k] = struct{}{}
+		}
+	}
+	return in, out
+}
+
+// Any reports whether pred returns true for any element of Set s, the
+// traversal stops at the first such element. It returns false for an
+// empty Set.
+func (s Set[T]) Any(pred func(i T) bool) bool {
+	for k := range s {
+		if pred(k) {
+			return true
+		}
+	}
+	return false
+}
+
+// Every reports whether pred returns true for every element of Set s, the
+// traversal stops at the first element for which pred returns false. It
+// returns true for an empty Set.
+//
+// Every is the All predicate of other collection libraries, it is not
+// named All because All returns the iterator of the Set on Go 1.23 and
+// later, as it does for the maps and slices packages.
+func (s Set[T]) Every(pred func(i T) bool) bool {
+	for k := range s {
+		if !pred(k) {
+			return false
+		}
+	}
+	return true
+}
+
+// Find returns an arbitrary element of Set s for which pred returns true,
+// the traversal stops at that element. The second value is false if there
+// is no such element.
+func (s Set[T]) Find(pred func(i T) bool) (T, bool) {
+	for k := range s {
+		if pred(k) {
+			return k, true
+		}
+	}
+	var zero T
+	return zero, false
+}
+
+// Count returns the number of elements of Set s for which pred returns true.
+func (s Set[T]) Count(pred func(i T) bool) int {
+	n := 0
+	for k := range s {
+		if pred(k) {
+			n++
+		}
+	}
+	return n
+}
+
+// Map returns a new Set with the results of calling f on the elements of
+// Set s, elements mapped to the same result are merged.
+// For example:
+// s = {-2, 1, 2}
+// Map(s, strconv.Itoa) = {"-2", "1", "2"}
+// Map(s, abs) = {1, 2}
+func Map[T, U comparable](s Set[T], f func(i T) U) Set[U] {
+	u := NewWithSize[U](len(s))
+	for k := range s {
+		u[f(k)] = struct{}{}
+	}
+	return u
+}
+
+// MapInt returns a new Int with the results of calling f on the elements of
+// Set s, elements mapped to the same result are merged.
+//
+// Deprecated: Use Map, which maps to a set of any element type, such as
+// Map(s, f) for an Int.
+func (s Set[T]) MapInt(f func(i T) int) Int {
+	return Map(s, f)
+}
+
+// MapE is Map with a function which may fail, the traversal is stopped when
+// f returns error.
+// if err is ErrBreakEach, break the cycle and return the results so far,
+// else, break the cycle and return nil and the error.
+func MapE[T, U comparable](s Set[T], f func(i T) (U, error)) (Set[U], error) {
+	u := NewWithSize[U](len(s))
+	for k := range s {
+		v, err := f(k)
+		if err != nil {
+			if err == ErrBreakEach {
+				return u, nil
+			}
+			return nil, err
+		}
+		u[v] = struct{}{}
+	}
+	return u, nil
+}
+
+// Reduce folds the elements of Set s into an accumulator, starting with
+// init and calling f with the accumulator and each element in no particular
+// order, so f should not depend on the order.
+// For example:
+// s = {1, 2, 3}
+// Reduce(s, 0, sum) = 6
+func Reduce[T comparable, A any](s Set[T], init A, f func(acc A, i T) A) A {
+	acc := init
+	for k := range s {
+		acc = f(acc, k)
+	}
+	return acc
+}
+
+// ReduceE is Reduce with a function which may fail, the traversal is stopped
+// when f returns error.
+// if err is ErrBreakEach, break the cycle and return the accumulator f
+// returned along with it,
+// else, break the cycle and return the error.
+func ReduceE[T comparable, A any](s Set[T], init A, f func(acc A, i T) (A, error)) (A, error) {
+	acc := init
+	for k := range s {
+		next, err := f(acc, k)
+		if err != nil {
+			if err == ErrBreakEach {
+				return next, nil
+			}
+			return acc, err
+		}
+		acc = next
+	}
+	return acc, nil
+}
+
+// partitionElements returns the elements visited by each for which pred
+// returns true and the others, in the order of the traversal.
+func partitionElements[T any](each func(do func(i T)), pred func(i T) bool) ([]T, []T) {
+	var in, out []T
+	each(func(i T) {
+		if pred(i) {
+			in = append(in, i)
+		} else {
+			out = append(out, i)
+		}
+	})
+	return in, out
+}
+
+// findElement returns the first element visited by eachE for which pred
+// returns true, it breaks the traversal with ErrBreakEach once found.
+func findElement[T any](eachE func(do func(i T) error) error, pred func(i T) bool) (T, bool) {
+	var found T
+	ok := false
+	_ = eachE(func(i T) error {
+		if pred(i) {
+			found, ok = i, true
+			return ErrBreakEach
+		}
+		return nil
+	})
+	return found, ok
+}
+
+// countElements returns the number of elements visited by each for which
+// pred returns true.
+func countElements[T any](each func(do func(i T)), pred func(i T) bool) int {
+	n := 0
+	each(func(i T) {
+		if pred(i) {
+			n++
+		}
+	})
+	return n
+}
@@ -0,0 +1,323 @@
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package set
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func even(i int) bool {
+	return i%2 == 0
+}
+
+func TestSet_Filter(t *testing.T) {
+	testcases := []struct {
+		name   string
+		s      Int
+		expect []int
+		in     []int
+		out    []int
+	}{
+		{name: "nil", s: nil, expect: []int{}, in: []int{}, out: []int{}},
+		{name: "none", s: NewInt(1, 3), expect: []int{}, in: []int{}, out: []int{1, 3}},
+		{name: "some", s: NewInt(1, 2, 3, 4), expect: []int{2, 4}, in: []int{2, 4}, out: []int{1, 3}},
+		{name: "all", s: NewInt(2, 4), expect: []int{2, 4}, in: []int{2, 4}, out: []int{}},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		f := tc.s.Filter(even)
+		if f == nil {
+			t.Errorf("expect non nil set")
+		}
+		validateSet(t, f, tc.expect)
+		in, out := tc.s.Partition(even)
+		validateSet(t, in, tc.in)
+		validateSet(t, out, tc.out)
+	}
+}
+
+func TestSet_Predicates(t *testing.T) {
+	testcases := []struct {
+		name  string
+		s     Int
+		any   bool
+		every bool
+		count int
+	}{
+		{name: "nil", s: nil, any: false, every: true, count: 0},
+		{name: "none", s: NewInt(1, 3), any: false, every: false, count: 0},
+		{name: "some", s: NewInt(1, 2, 3, 4), any: true, every: false, count: 2},
+		{name: "all", s: NewInt(2, 4), any: true, every: true, count: 2},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		if got := tc.s.Any(even); got != tc.any {
+			t.Errorf("expect any: %v, but got: %v", tc.any, got)
+		}
+		if got := tc.s.Every(even); got != tc.every {
+			t.Errorf("expect every: %v, but got: %v", tc.every, got)
+		}
+		if got := tc.s.Count(even); got != tc.count {
+			t.Errorf("expect count: %d, but got: %d", tc.count, got)
+		}
+		found, ok := tc.s.Find(even)
+		if ok != tc.any || ok && (!even(found) || !tc.s.Has(found)) || !ok && found != 0 {
+			t.Errorf("expect found: %v, but got: %v, %v", tc.any, found, ok)
+		}
+	}
+}
+
+func TestSet_PredicatesStopEarly(t *testing.T) {
+	s := NewInt(1, 2, 3, 4, 5, 6)
+	calls := 0
+	count := func(pred func(int) bool) func(int) bool {
+		calls = 0
+		return func(i int) bool {
+			calls++
+			return pred(i)
+		}
+	}
+	s.Any(count(func(int) bool { return true }))
+	if calls != 1 {
+		t.Errorf("expect Any to stop after: 1 call, but got: %d", calls)
+	}
+	s.Every(count(func(int) bool { return false }))
+	if calls != 1 {
+		t.Errorf("expect Every to stop after: 1 call, but got: %d", calls)
+	}
+	s.Find(count(func(int) bool { return true }))
+	if calls != 1 {
+		t.Errorf("expect Find to stop after: 1 call, but got: %d", calls)
+	}
+	s.Count(count(func(int) bool { return true }))
+	if calls != 6 {
+		t.Errorf("expect Count to call: 6 times, but got: %d", calls)
+	}
+}
+
+func TestMap(t *testing.T) {
+	s := NewInt(-2, 1, 2)
+	validateSet(t, Map(s, strconv.Itoa), []string{"-2", "1", "2"})
+	abs := Map(s, func(i int) int {
+		if i < 0 {
+			return -i
+		}
+		return i
+	})
+	validateSet(t, abs, []int{1, 2})
+	validateSet(t, Map(Int(nil), strconv.Itoa), []string{})
+	validateSet(t, NewString("a", "bb", "cc").MapInt(func(i string) int { return len(i) }), []int{1, 2})
+
+	parsed, err := MapE(NewString("1", "2"), strconv.Atoi)
+	if err != nil {
+		t.Fatalf("expect no error, but got: %v", err)
+	}
+	validateSet(t, parsed, []int{1, 2})
+	parsed, err = MapE(NewString("1", "x"), strconv.Atoi)
+	if err == nil || parsed != nil {
+		t.Errorf("expect error and nil set, but got: %v, %v", parsed, err)
+	}
+	parsed, err = MapE(NewString("1", "2", "3"), func(i string) (int, error) {
+		return 0, ErrBreakEach
+	})
+	if err != nil || parsed.Size() != 0 {
+		t.Errorf("expect empty set and no error, but got: %v, %v", parsed, err)
+	}
+}
+
+func TestReduce(t *testing.T) {
+	sum := func(acc, i int) int {
+		return acc + i
+	}
+	if got := Reduce(NewInt(1, 2, 3), 10, sum); got != 16 {
+		t.Errorf("expect sum: 16, but got: %d", got)
+	}
+	if got := Reduce(Int(nil), 10, sum); got != 10 {
+		t.Errorf("expect init: 10, but got: %d", got)
+	}
+	lengths := Reduce(NewString("a", "bb"), map[int]bool{}, func(acc map[int]bool, i string) map[int]bool {
+		acc[len(i)] = true
+		return acc
+	})
+	if !reflect.DeepEqual(lengths, map[int]bool{1: true, 2: true}) {
+		t.Errorf("expect lengths: %v, but got: %v", map[int]bool{1: true, 2: true}, lengths)
+	}
+
+	errOdd := errors.New("odd")
+	testcases := []struct {
+		name   string
+		s      Int
+		f      func(acc, i int) (int, error)
+		expect int
+		err    error
+	}{
+		{
+			name: "no error",
+			s:    NewInt(2, 4),
+			f: func(acc, i int) (int, error) {
+				return acc + i, nil
+			},
+			expect: 6,
+		},
+		{
+			name: "error keeps the accumulator before the failing element",
+			s:    NewInt(1),
+			f: func(acc, i int) (int, error) {
+				return -1, errOdd
+			},
+			expect: 0,
+			err:    errOdd,
+		},
+		{
+			name: "break returns the accumulator returned with it",
+			s:    NewInt(1, 2, 3),
+			f: func(acc, i int) (int, error) {
+				return 42, ErrBreakEach
+			},
+			expect: 42,
+		},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		got, err := ReduceE(tc.s, 0, tc.f)
+		if err != tc.err {
+			t.Errorf("expect error: %v, but got: %v", tc.err, err)
+		}
+		if got != tc.expect {
+			t.Errorf("expect accumulator: %d, but got: %d", tc.expect, got)
+		}
+	}
+}
+
+// combinators are the functional methods shared by the set types.
+type combinators[T any] interface {
+	Any(pred func(i T) bool) bool
+	Every(pred func(i T) bool) bool
+	Find(pred func(i T) bool) (T, bool)
+	Count(pred func(i T) bool) int
+	List() []T
+}
+
+func TestCombinators(t *testing.T) {
+	gt2 := func(i uint32) bool {
+		return i > 2
+	}
+	elements := []uint32{5, 1, 4, 2, 3}
+	testcases := []struct {
+		name  string
+		s     combinators[uint32]
+		first uint32
+	}{
+		{
+			name: "Sync",
+			s:    NewSync(elements...),
+		},
+		{
+			name: "Sharded",
+			s:    NewSharded(elements...),
+		},
+		{
+			name:  "Roaring",
+			s:     NewRoaring(elements...),
+			first: 3,
+		},
+		{
+			name:  "OrderedSet",
+			s:     NewOrderedSet(elements...),
+			first: 3,
+		},
+		{
+			name:  "LinkedSet",
+			s:     NewLinkedSet(elements...),
+			first: 5,
+		},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		if !tc.s.Any(gt2) || tc.s.Every(gt2) || tc.s.Count(gt2) != 3 {
+			t.Errorf("expect any, not every and a count of 3 for %v", tc.s.List())
+		}
+		if tc.s.Any(func(i uint32) bool { return i > 5 }) || !tc.s.Every(func(i uint32) bool { return i > 0 }) {
+			t.Errorf("expect no element above 5 and every element above 0 for %v", tc.s.List())
+		}
+		found, ok := tc.s.Find(gt2)
+		if !ok || found <= 2 || tc.first != 0 && found != tc.first {
+			t.Errorf("expect found: %d, but got: %d, %v", tc.first, found, ok)
+		}
+	}
+
+	sync := NewSync(elements...)
+	in, out := sync.Partition(gt2)
+	validateSet(t, sync.Filter(gt2).Snapshot(), []uint32{3, 4, 5})
+	validateSet(t, in.Snapshot(), []uint32{3, 4, 5})
+	validateSet(t, out.Snapshot(), []uint32{1, 2})
+
+	sharded := NewShardedWithShards[uint32](2)
+	sharded.Add(elements...)
+	shardedIn, shardedOut := sharded.Partition(gt2)
+	if len(shardedIn.shards) != 2 || len(sharded.Filter(gt2).shards) != 2 {
+		t.Errorf("expect results with: 2 shards, but got: %d", len(shardedIn.shards))
+	}
+	validateSet(t, shardedIn.Snapshot(), []uint32{3, 4, 5})
+	validateSet(t, shardedOut.Snapshot(), []uint32{1, 2})
+
+	roaring := NewRoaring(elements...)
+	roaringIn, roaringOut := roaring.Partition(gt2)
+	if !roaring.Filter(gt2).Equal(roaringIn) || !roaringIn.Equal(NewRoaring[uint32](3, 4, 5)) || !roaringOut.Equal(NewRoaring[uint32](1, 2)) {
+		t.Errorf("expect partition: [3, 4, 5] [1, 2], but got: %v %v", roaringIn, roaringOut)
+	}
+
+	ordered := NewOrderedSet(elements...)
+	orderedIn, orderedOut := ordered.Partition(gt2)
+	validateTree(t, orderedIn.root)
+	validateTree(t, orderedOut.root)
+	if !ordered.Filter(gt2).Equal(orderedIn) || !orderedIn.Equal(NewOrderedSet[uint32](3, 4, 5)) || !orderedOut.Equal(NewOrderedSet[uint32](1, 2)) {
+		t.Errorf("expect partition: [3, 4, 5] [1, 2], but got: %v %v", orderedIn, orderedOut)
+	}
+
+	linked := NewLinkedSet(elements...)
+	linkedIn, linkedOut := linked.Partition(gt2)
+	if got := linked.Filter(gt2).List(); !reflect.DeepEqual(got, []uint32{5, 4, 3}) {
+		t.Errorf("expect filter in insertion order: [5 4 3], but got: %v", got)
+	}
+	if !reflect.DeepEqual(linkedIn.List(), []uint32{5, 4, 3}) || !reflect.DeepEqual(linkedOut.List(), []uint32{1, 2}) {
+		t.Errorf("expect partition: [5 4 3] [1 2], but got: %v %v", linkedIn.List(), linkedOut.List())
+	}
+
+	bitset := NewBitset[uint8](5, 1, 4, 2, 3)
+	bitsetIn, bitsetOut := bitset.Partition(func(i uint8) bool { return i > 2 })
+	if !bitsetIn.Equal(NewBitset[uint8](3, 4, 5)) || !bitsetOut.Equal(NewBitset[uint8](1, 2)) {
+		t.Errorf("expect partition: [3, 4, 5] [1, 2], but got: %v %v", bitsetIn, bitsetOut)
+	}
+	if found, ok := bitset.Find(func(i uint8) bool { return i > 2 }); !ok || found != 3 {
+		t.Errorf("expect found: 3, but got: %d, %v", found, ok)
+	}
+	if bitset.Count(func(i uint8) bool { return i > 2 }) != 3 || !bitset.Any(func(i uint8) bool { return i == 1 }) || bitset.Every(func(i uint8) bool { return i == 1 }) {
+		t.Errorf("expect count 3, any and not every for %v", bitset)
+	}
+}
@@ -1,3 +1,3 @@
 module github.com/SeananXu/go-set
 
-go 1.14
+go 1.20
@@ -0,0 +1,95 @@
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package set
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+	"reflect"
+)
+
+// GobError is returned by GobEncode and GobDecode when an element of a set
+// cannot be encoded or decoded by encoding/gob, Type names the dynamic type
+// of the element.
+type GobError struct {
+	Type string
+	Err  error
+}
+
+func (e *GobError) Error() string {
+	return fmt.Sprintf("set: gob element of type %s: %v", e.Type, e.Err)
+}
+
+func (e *GobError) Unwrap() error {
+	return e.Err
+}
+
+// GobEncode implements gob.GobEncoder, it encodes the elements of the Set as
+// a slice in their natural order.
+//
+// The concrete types held by an Interface, other than the basic types, must
+// be registered with gob.Register before they are encoded or decoded, as
+// for any interface value sent by gob. If an element cannot be encoded, the
+// error is a *GobError naming the type of the element.
+func (s Set[T]) GobEncode() ([]byte, error) {
+	v := s.List()
+	sortElements(v)
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(v); err != nil {
+		// find the element which cannot be encoded to report its type
+		for _, element := range v {
+			if err := gob.NewEncoder(&bytes.Buffer{}).Encode([]T{element}); err != nil {
+				return nil, &GobError{Type: fmt.Sprintf("%T", element), Err: err}
+			}
+		}
+		return nil, fmt.Errorf("set: gob: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode implements gob.GobDecoder, it replaces the Set with the elements
+// decoded by GobEncode. Interface elements which are not hashable, such as
+// slices of a registered type, are rejected with a *GobError rather than
+// panicking when added to the Set.
+func (s *Set[T]) GobDecode(data []byte) error {
+	var v []T
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&v); err != nil {
+		return fmt.Errorf("set: gob: %w", err)
+	}
+	if holdsInterface(reflect.TypeOf((*T)(nil)).Elem()) {
+		for i, element := range v {
+			if !hashable(reflect.ValueOf(&element).Elem()) {
+				return &GobError{Type: fmt.Sprintf("%T", element), Err: fmt.Errorf("element %d is not hashable", i)}
+			}
+		}
+	}
+	u := NewWithSize[T](len(v))
+	for _, element := range v {
+		u[element] = struct{}{}
+	}
+	*s = u
+	return nil
+}
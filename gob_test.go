@@ -0,0 +1,143 @@
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package set
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"testing"
+)
+
+type gobPoint struct {
+	X, Y int
+}
+
+type gobUnregistered struct {
+	Name string
+}
+
+type gobSlice []int
+
+func init() {
+	gob.Register(gobPoint{})
+	gob.Register(gobSlice{})
+}
+
+// gobRoundTrip encodes src with gob and decodes it into dest.
+func gobRoundTrip(src, dest interface{}) error {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(src); err != nil {
+		return err
+	}
+	return gob.NewDecoder(&buf).Decode(dest)
+}
+
+func TestSet_Gob(t *testing.T) {
+	testcases := []struct {
+		name   string
+		input  Interface
+		expect []interface{}
+	}{
+		{
+			name:   "empty",
+			input:  NewInterface(),
+			expect: nil,
+		},
+		{
+			name:   "basic types",
+			input:  NewInterface(1, "a", 1.5, true, int8(2), uint64(3)),
+			expect: []interface{}{1, "a", 1.5, true, int8(2), uint64(3)},
+		},
+		{
+			name:   "nil element",
+			input:  NewInterface(nil, 1),
+			expect: []interface{}{nil, 1},
+		},
+		{
+			name:   "registered type",
+			input:  NewInterface(gobPoint{1, 2}, gobPoint{2, 1}, 1),
+			expect: []interface{}{gobPoint{1, 2}, gobPoint{2, 1}, 1},
+		},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		actual := NewInterface("stale")
+		if err := gobRoundTrip(tc.input, &actual); err != nil {
+			t.Fatalf("expect no error, but got: %v", err)
+		}
+		validateSet(t, actual, tc.expect)
+	}
+
+	var s String
+	if err := gobRoundTrip(NewString("b", "a"), &s); err != nil {
+		t.Fatalf("expect no error, but got: %v", err)
+	}
+	validateSet(t, s, []string{"a", "b"})
+	var f Float64
+	if err := gobRoundTrip(NewFloat64(0.5, -1), &f); err != nil {
+		t.Fatalf("expect no error, but got: %v", err)
+	}
+	validateSet(t, f, []float64{0.5, -1})
+	y := NewSync[uint16]()
+	if err := gobRoundTrip(NewSync[uint16](1, 2), y); err != nil || !y.Equal(NewSync[uint16](1, 2)) {
+		t.Errorf("expect [1, 2], but got: %v, %v", y, err)
+	}
+
+	type record struct {
+		Tags String
+		IDs  Int
+	}
+	var r record
+	if err := gobRoundTrip(record{Tags: NewString("x"), IDs: NewInt(1, 2)}, &r); err != nil {
+		t.Fatalf("expect no error, but got: %v", err)
+	}
+	validateSet(t, r.Tags, []string{"x"})
+	validateSet(t, r.IDs, []int{1, 2})
+}
+
+func TestSet_GobError(t *testing.T) {
+	var ge *GobError
+	_, err := NewInterface(1, gobUnregistered{"a"}).GobEncode()
+	if !errors.As(err, &ge) || ge.Type != "set.gobUnregistered" {
+		t.Errorf("expect GobError naming set.gobUnregistered, but got: %v", err)
+	}
+
+	// a set holding a registered slice type cannot be built, encode the
+	// slice directly to check that decoding rejects it
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode([]interface{}{1, gobSlice{1}}); err != nil {
+		t.Fatalf("expect no error, but got: %v", err)
+	}
+	s := NewInterface("stale")
+	if err := s.GobDecode(buf.Bytes()); !errors.As(err, &ge) || ge.Type != "set.gobSlice" {
+		t.Errorf("expect GobError naming set.gobSlice, but got: %v", err)
+	}
+	validateSet(t, s, []interface{}{"stale"})
+
+	if err := s.GobDecode([]byte{1, 2, 3}); err == nil {
+		t.Errorf("expect error on corrupt data")
+	}
+}
@@ -0,0 +1,149 @@
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package set
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// ErrUnhashable is matched by errors.Is for an *UnhashableError.
+var ErrUnhashable = errors.New("unhashable element")
+
+// UnhashableError is returned by TryAdd, TryHas and TryRemove when an
+// element holds a value which cannot be used as a map key, such as a slice,
+// a map or a func held by an interface, which would make Add panic.
+type UnhashableError struct {
+	// Element is the dynamic type of the element.
+	Element string
+	// Type is the dynamic type of the unhashable value.
+	Type string
+	// Path locates the unhashable value in the element, such as .Tags or
+	// [1].Tags, it is empty if the element itself is unhashable.
+	Path string
+}
+
+func (e *UnhashableError) Error() string {
+	if e.Path == "" {
+		return fmt.Sprintf("set: element of type %s is not hashable", e.Type)
+	}
+	return fmt.Sprintf("set: element of type %s is not hashable: %s at %s", e.Element, e.Type, e.Path)
+}
+
+// Is reports whether target is ErrUnhashable.
+func (e *UnhashableError) Is(target error) bool {
+	return target == ErrUnhashable
+}
+
+// checkHashable returns an *UnhashableError if element cannot be used as a
+// map key.
+func checkHashable(element interface{}) error {
+	v := reflect.ValueOf(&element).Elem()
+	t, path, ok := findUnhashable(v, "")
+	if !ok {
+		return nil
+	}
+	return &UnhashableError{Element: fmt.Sprintf("%T", element), Type: t.String(), Path: path}
+}
+
+// findUnhashable returns the type and the path of the first value in v which
+// cannot be used as a map key, it looks into the dynamic values held by
+// interfaces, structs and arrays.
+func findUnhashable(v reflect.Value, path string) (reflect.Type, string, bool) {
+	switch v.Kind() {
+	case reflect.Interface:
+		if v.IsNil() {
+			return nil, "", false
+		}
+		return findUnhashable(v.Elem(), path)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if t, p, ok := findUnhashable(v.Field(i), path+"."+v.Type().Field(i).Name); ok {
+				return t, p, true
+			}
+		}
+		return nil, "", false
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if t, p, ok := findUnhashable(v.Index(i), path+"["+strconv.Itoa(i)+"]"); ok {
+				return t, p, true
+			}
+		}
+		return nil, "", false
+	default:
+		if v.Type().Comparable() {
+			return nil, "", false
+		}
+		return v.Type(), path, true
+	}
+}
+
+// TryAdd adds the elements to Set as Add does, unless one of them is not
+// hashable: it then returns an *UnhashableError and adds none of them.
+// Only the elements which may hold an interface are checked, the other
+// element types are always hashable.
+func (s Set[T]) TryAdd(elements ...T) error {
+	if err := checkElements(elements); err != nil {
+		return err
+	}
+	s.Add(elements...)
+	return nil
+}
+
+// TryHas judges whether the element exists in the Set as Has does, it
+// returns an *UnhashableError if the element is not hashable.
+func (s Set[T]) TryHas(element T) (bool, error) {
+	if err := checkElements([]T{element}); err != nil {
+		return false, err
+	}
+	return s.Has(element), nil
+}
+
+// TryRemove removes the elements from the Set as Remove does, unless one of
+// them is not hashable: it then returns an *UnhashableError and removes none
+// of them.
+func (s Set[T]) TryRemove(elements ...T) error {
+	if err := checkElements(elements); err != nil {
+		return err
+	}
+	s.Remove(elements...)
+	return nil
+}
+
+// checkElements returns an *UnhashableError for the first element which is
+// not hashable.
+func checkElements[T comparable](elements []T) error {
+	if !holdsInterface(reflect.TypeOf((*T)(nil)).Elem()) {
+		return nil
+	}
+	for _, element := range elements {
+		if err := checkHashable(element); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package set
+
+import (
+	"errors"
+	"testing"
+)
+
+type tagged struct {
+	Name string
+	Tags []string
+}
+
+type nested struct {
+	ID    int
+	Inner [2]interface{}
+}
+
+func TestSet_TryAdd(t *testing.T) {
+	testcases := []struct {
+		name    string
+		element interface{}
+		err     string
+	}{
+		{name: "hashable", element: 1},
+		{name: "hashable struct", element: struct{ A interface{} }{A: "a"}},
+		{name: "nil", element: nil},
+		{name: "slice", element: []int{1}, err: "set: element of type []int is not hashable"},
+		{name: "map", element: map[string]int{}, err: "set: element of type map[string]int is not hashable"},
+		{name: "func", element: func() {}, err: "set: element of type func() is not hashable"},
+		{
+			name:    "slice in struct",
+			element: tagged{Name: "a"},
+			err:     "set: element of type set.tagged is not hashable: []string at .Tags",
+		},
+		{
+			name:    "slice in array",
+			element: [2]interface{}{1, []byte("b")},
+			err:     "set: element of type [2]interface {} is not hashable: []uint8 at [1]",
+		},
+		{
+			name:    "map in array in struct",
+			element: nested{Inner: [2]interface{}{1, map[int]int{}}},
+			err:     "set: element of type set.nested is not hashable: map[int]int at .Inner[1]",
+		},
+		{
+			name:    "struct in interface in array in struct",
+			element: nested{Inner: [2]interface{}{tagged{}, 1}},
+			err:     "set: element of type set.nested is not hashable: []string at .Inner[0].Tags",
+		},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		s := NewInterface("stale")
+		err := s.TryAdd("a", tc.element)
+		if tc.err == "" {
+			if err != nil {
+				t.Fatalf("expect no error, but got: %v", err)
+			}
+			if ok, err := s.TryHas(tc.element); !ok || err != nil {
+				t.Errorf("expect element present, but got: %v, %v", ok, err)
+			}
+			if err := s.TryRemove(tc.element); err != nil || s.Has(tc.element) {
+				t.Errorf("expect element removed, but got: %v", err)
+			}
+			continue
+		}
+		var unhashable *UnhashableError
+		if !errors.As(err, &unhashable) || err.Error() != tc.err {
+			t.Errorf("expect error: %s, but got: %v", tc.err, err)
+		}
+		if !errors.Is(err, ErrUnhashable) {
+			t.Errorf("expect error matching ErrUnhashable, but got: %v", err)
+		}
+		validateSet(t, s, []interface{}{"stale"})
+		if ok, err := s.TryHas(tc.element); ok || !errors.Is(err, ErrUnhashable) {
+			t.Errorf("expect TryHas error, but got: %v, %v", ok, err)
+		}
+		if err := s.TryRemove("stale", tc.element); !errors.Is(err, ErrUnhashable) || !s.Has("stale") {
+			t.Errorf("expect TryRemove error and no removal, but got: %v", err)
+		}
+	}
+}
+
+func TestSet_TryAddHashableTypes(t *testing.T) {
+	s := NewString()
+	if err := s.TryAdd("a", "b"); err != nil {
+		t.Fatalf("expect no error, but got: %v", err)
+	}
+	validateSet(t, s, []string{"a", "b"})
+
+	p := New[formatPoint]()
+	if err := p.TryAdd(formatPoint{X: 1}); err != nil {
+		t.Fatalf("expect no error, but got: %v", err)
+	}
+	if ok, err := p.TryHas(formatPoint{X: 1}); !ok || err != nil {
+		t.Errorf("expect element present, but got: %v, %v", ok, err)
+	}
+
+	n := New[nested]()
+	err := n.TryAdd(nested{ID: 1}, nested{Inner: [2]interface{}{[]int{}}})
+	if !errors.Is(err, ErrUnhashable) || n.Size() != 0 {
+		t.Errorf("expect error and no element added, but got: %v, %v", err, n)
+	}
+}
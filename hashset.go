@@ -0,0 +1,368 @@
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package set
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// HashSet is a collection of elements that contains no duplicate elements,
+// without any particular order, where the elements are compared with the
+// hash and equal funcs given to NewHashSet instead of ==. It holds the
+// elements which a Set cannot express, for example:
+//
+//	// names compared case-insensitively
+//	seed := maphash.MakeSeed()
+//	s := set.NewHashSet(
+//		func(name string) uint64 { return maphash.String(seed, strings.ToLower(name)) },
+//		strings.EqualFold,
+//	)
+//	s.Add("Go", "GO") // s = {Go}
+//
+// Elements which are equal must have the same hash, and equal must be an
+// equivalence relation, or the set may hold duplicates. The elements are
+// chained by their hash, so the lookups are O(1) when the hash spreads the
+// elements well. Mutating an element in a way which changes its hash while
+// it is in the set makes it unreachable.
+//
+// The operations taking another HashSet expect both sets to use the same
+// funcs, the result uses the funcs of the receiver.
+// A HashSet must be created with NewHashSet.
+type HashSet[T any] struct {
+	hash  func(T) uint64
+	equal func(a, b T) bool
+	// buckets maps a hash to the members which have it.
+	buckets map[uint64][]T
+	n       int
+}
+
+// NewHashSet initializes a new HashSet which compares the elements with
+// hash and equal, it panics if either func is nil.
+func NewHashSet[T any](hash func(T) uint64, equal func(a, b T) bool, elements ...T) *HashSet[T] {
+	if hash == nil || equal == nil {
+		panic("set: NewHashSet requires a hash and an equal func")
+	}
+	h := &HashSet[T]{hash: hash, equal: equal, buckets: map[uint64][]T{}}
+	h.Add(elements...)
+	return h
+}
+
+// empty returns an empty HashSet with the funcs of HashSet h.
+func (h *HashSet[T]) empty() *HashSet[T] {
+	return &HashSet[T]{hash: h.hash, equal: h.equal, buckets: map[uint64][]T{}}
+}
+
+// find returns the hash of element and the index of the member equal to it
+// in its bucket.
+func (h *HashSet[T]) find(element T) (uint64, int, bool) {
+	key := h.hash(element)
+	for i, member := range h.buckets[key] {
+		if h.equal(member, element) {
+			return key, i, true
+		}
+	}
+	return key, 0, false
+}
+
+// Add adds the elements to HashSet, if it is not present already. The
+// member which was added first is kept among equal elements.
+func (h *HashSet[T]) Add(elements ...T) {
+	for _, element := range elements {
+		if key, _, ok := h.find(element); !ok {
+			h.buckets[key] = append(h.buckets[key], element)
+			h.n++
+		}
+	}
+}
+
+// Remove removes the elements from HashSet, if it is present.
+func (h *HashSet[T]) Remove(elements ...T) {
+	for _, element := range elements {
+		key, i, ok := h.find(element)
+		if !ok {
+			continue
+		}
+		bucket := h.buckets[key]
+		if len(bucket) == 1 {
+			delete(h.buckets, key)
+		} else {
+			// clear the vacated slot so that the element can be collected
+			var zero T
+			copy(bucket[i:], bucket[i+1:])
+			bucket[len(bucket)-1] = zero
+			h.buckets[key] = bucket[:len(bucket)-1]
+		}
+		h.n--
+	}
+}
+
+// Pop returns an arbitrary element of HashSet, deleting it from HashSet.
+// The second value is a bool that is true if the elements existed in
+// the HashSet, and false if not.
+func (h *HashSet[T]) Pop() (T, bool) {
+	for _, bucket := range h.buckets {
+		element := bucket[0]
+		h.Remove(element)
+		return element, true
+	}
+	var zero T
+	return zero, false
+}
+
+// Size returns the number of elements in HashSet.
+func (h *HashSet[T]) Size() int {
+	return h.n
+}
+
+// IsEmpty returns whether the HashSet is Empty.
+func (h *HashSet[T]) IsEmpty() bool {
+	return h.n == 0
+}
+
+// Clear removes all items from the HashSet.
+func (h *HashSet[T]) Clear() {
+	h.buckets = map[uint64][]T{}
+	h.n = 0
+}
+
+// Has judges the specified element whether exists in the HashSet.
+// it returns true if existed, and false if not.
+func (h *HashSet[T]) Has(element T) bool {
+	_, _, ok := h.find(element)
+	return ok
+}
+
+// HasAll looks for the specified elements to judge
+// whether all of them exist in the HashSet.
+func (h *HashSet[T]) HasAll(elements ...T) bool {
+	for _, element := range elements {
+		if !h.Has(element) {
+			return false
+		}
+	}
+	return true
+}
+
+// HasAny looks for the specified elements to judge
+// whether at least one of them exists in the HashSet.
+func (h *HashSet[T]) HasAny(elements ...T) bool {
+	for _, element := range elements {
+		if h.Has(element) {
+			return true
+		}
+	}
+	return false
+}
+
+// List returns the all elements as a slice, without any particular order.
+func (h *HashSet[T]) List() []T {
+	v := make([]T, 0, h.n)
+	for _, bucket := range h.buckets {
+		v = append(v, bucket...)
+	}
+	return v
+}
+
+// SortedList returns the all elements as a slice, sorted by less.
+func (h *HashSet[T]) SortedList(less func(i, j T) bool) []T {
+	v := h.List()
+	sort.Slice(v, func(i, j int) bool {
+		return less(v[i], v[j])
+	})
+	return v
+}
+
+// EachE traverses the elements in the HashSet, calling do func for each
+// HashSet member. the cycle will be stopped when the do func returns error.
+// if err is ErrBreakEach, break the cycle and return nil,
+// else, break the cycle and return error.
+func (h *HashSet[T]) EachE(do func(i T) error) error {
+	for _, bucket := range h.buckets {
+		for _, element := range bucket {
+			if err := do(element); err != nil {
+				if err == ErrBreakEach {
+					return nil
+				}
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Each traverses the elements in the HashSet, calling do func for each
+// HashSet member.
+func (h *HashSet[T]) Each(do func(i T)) {
+	for _, bucket := range h.buckets {
+		for _, element := range bucket {
+			do(element)
+		}
+	}
+}
+
+// combine returns a new HashSet which is op of HashSet h and g, with the
+// funcs of h.
+func (h *HashSet[T]) combine(g *HashSet[T], op int) *HashSet[T] {
+	u := h.empty()
+	h.Each(func(i T) {
+		if g.Has(i) == (op == opAnd) || op == opOr {
+			u.Add(i)
+		}
+	})
+	if op == opOr || op == opXor {
+		g.Each(func(i T) {
+			if !h.Has(i) {
+				u.Add(i)
+			}
+		})
+	}
+	return u
+}
+
+// Union returns the union of HashSet h and g, the members of h are kept
+// among equal elements.
+func (h *HashSet[T]) Union(g *HashSet[T]) *HashSet[T] {
+	return h.combine(g, opOr)
+}
+
+// Difference returns the difference of HashSet h and g.
+func (h *HashSet[T]) Difference(g *HashSet[T]) *HashSet[T] {
+	return h.combine(g, opAndNot)
+}
+
+// Intersection returns the intersection of HashSet h and g, the members
+// of h are kept.
+func (h *HashSet[T]) Intersection(g *HashSet[T]) *HashSet[T] {
+	return h.combine(g, opAnd)
+}
+
+// SymmetricDifference returns a new HashSet with the elements that are either in this HashSet
+// or in the given HashSet, but not in both.
+func (h *HashSet[T]) SymmetricDifference(g *HashSet[T]) *HashSet[T] {
+	return h.combine(g, opXor)
+}
+
+// UnionWith adds all elements of HashSet g to HashSet h.
+func (h *HashSet[T]) UnionWith(g *HashSet[T]) {
+	if g == h {
+		return
+	}
+	g.Each(func(i T) {
+		h.Add(i)
+	})
+}
+
+// DifferenceWith removes all elements of HashSet g from HashSet h.
+func (h *HashSet[T]) DifferenceWith(g *HashSet[T]) {
+	// Remove shifts the bucket that Each ranges over, so h cannot be
+	// traversed while removing from itself.
+	if g == h {
+		h.Clear()
+		return
+	}
+	g.Each(func(i T) {
+		h.Remove(i)
+	})
+}
+
+// IntersectWith removes the elements of HashSet h which are not in HashSet g.
+func (h *HashSet[T]) IntersectWith(g *HashSet[T]) {
+	*h = *h.combine(g, opAnd)
+}
+
+// SymmetricDifferenceWith keeps the elements that are either in HashSet h or
+// in HashSet g, but not in both.
+func (h *HashSet[T]) SymmetricDifferenceWith(g *HashSet[T]) {
+	*h = *h.combine(g, opXor)
+}
+
+// IsSubset predicates that tests whether the HashSet h is a subset of HashSet g.
+func (h *HashSet[T]) IsSubset(g *HashSet[T]) bool {
+	if h.n > g.n {
+		return false
+	}
+	err := h.EachE(func(i T) error {
+		if !g.Has(i) {
+			return errNotSubset
+		}
+		return nil
+	})
+	return err == nil
+}
+
+// IsSuperset predicates that tests whether the HashSet h is a super of HashSet g.
+func (h *HashSet[T]) IsSuperset(g *HashSet[T]) bool {
+	return g.IsSubset(h)
+}
+
+// Equal predicates that tests whether the HashSet h equals of HashSet g.
+func (h *HashSet[T]) Equal(g *HashSet[T]) bool {
+	return h.n == g.n && h.IsSubset(g)
+}
+
+// Copy returns new HashSet that clones from HashSet, with the same funcs.
+// The elements are shared and not copied.
+func (h *HashSet[T]) Copy() *HashSet[T] {
+	u := &HashSet[T]{hash: h.hash, equal: h.equal, buckets: make(map[uint64][]T, len(h.buckets)), n: h.n}
+	for key, bucket := range h.buckets {
+		u.buckets[key] = append([]T(nil), bucket...)
+	}
+	return u
+}
+
+// sortedList returns the elements in the order described by sortElements,
+// so that String is the same between runs. Pointers are ordered by address.
+func (h *HashSet[T]) sortedList() []T {
+	v := h.List()
+	sort.Slice(v, func(i, j int) bool {
+		return compareAny(v[i], v[j]) < 0
+	})
+	return v
+}
+
+// String returns a string representation of HashSet, the elements are
+// ordered by their type name and then by their Go-syntax representation.
+func (h *HashSet[T]) String() string {
+	return joinElements(h.sortedList(), "%v")
+}
+
+// Format implements fmt.Formatter, the elements are in the order of String.
+// See Set.Format for the verbs, the funcs cannot be written in Go syntax so
+// %#v names them hash and equal.
+func (h *HashSet[T]) Format(f fmt.State, verb rune) {
+	v := h.sortedList()
+	if verb == 'v' && f.Flag('#') {
+		args := []string{"hash", "equal"}
+		for i := range v {
+			args = append(args, goSyntax(reflect.ValueOf(&v[i]).Elem()))
+		}
+		fmt.Fprintf(f, "set.NewHashSet[%s](%s)", typeName[T](), strings.Join(args, ", "))
+		return
+	}
+	formatElements(f, verb, v, "NewHashSet["+typeName[T]()+"]")
+}
@@ -0,0 +1,225 @@
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package set
+
+import (
+	"bytes"
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"testing"
+)
+
+type user struct {
+	Name string
+	Age  int
+}
+
+func fnvHash(b []byte) uint64 {
+	h := fnv.New64a()
+	h.Write(b)
+	return h.Sum64()
+}
+
+func newUsers(users ...user) *HashSet[user] {
+	return NewHashSet(
+		func(u user) uint64 { return fnvHash([]byte(strings.ToLower(u.Name))) },
+		func(a, b user) bool { return strings.EqualFold(a.Name, b.Name) },
+		users...,
+	)
+}
+
+func newBytes(elements ...[]byte) *HashSet[[]byte] {
+	return NewHashSet(fnvHash, bytes.Equal, elements...)
+}
+
+func TestHashSet(t *testing.T) {
+	s := newUsers(user{Name: "Alice", Age: 1}, user{Name: "ALICE", Age: 2}, user{Name: "bob"})
+	if s.Size() != 2 {
+		t.Errorf("expect size: 2, but got: %d", s.Size())
+	}
+	if !s.Has(user{Name: "alice"}) || !s.HasAll(user{Name: "BOB"}, user{Name: "Alice"}) {
+		t.Errorf("expect case-insensitive lookups to succeed, but got: %v", s)
+	}
+	if s.HasAny(user{Name: "carol"}) {
+		t.Errorf("expect carol absent, but got: %v", s)
+	}
+	var age int
+	s.Each(func(u user) {
+		if strings.EqualFold(u.Name, "alice") {
+			age = u.Age
+		}
+	})
+	if age != 1 {
+		t.Errorf("expect the first member kept with age: 1, but got: %d", age)
+	}
+	s.Remove(user{Name: "aLiCe"})
+	if s.Size() != 1 || s.Has(user{Name: "Alice"}) {
+		t.Errorf("expect alice removed, but got: %v", s)
+	}
+
+	b := newBytes([]byte("a"), []byte("b"), []byte("a"))
+	if b.Size() != 2 || !b.Has([]byte("a")) || b.Has([]byte("c")) {
+		t.Errorf("expect {a, b}, but got: %q", b)
+	}
+
+	x, y := 1, 1
+	p := NewHashSet(
+		func(p *int) uint64 { return uint64(*p) },
+		func(a, b *int) bool { return *a == *b },
+		&x,
+	)
+	if !p.Has(&y) {
+		t.Errorf("expect pointers compared by pointee, but got: %v", p)
+	}
+}
+
+func TestHashSet_Collisions(t *testing.T) {
+	// every element has the same hash, so they are all in a single chain
+	s := NewHashSet(func(int) uint64 { return 0 }, func(a, b int) bool { return a == b }, 1, 2, 3, 2)
+	if s.Size() != 3 || !s.HasAll(1, 2, 3) {
+		t.Errorf("expect {1, 2, 3}, but got: %v", s)
+	}
+	s.Remove(2)
+	if s.Size() != 2 || s.Has(2) || !s.HasAll(1, 3) {
+		t.Errorf("expect {1, 3}, but got: %v", s)
+	}
+	n := 0
+	for !s.IsEmpty() {
+		if _, ok := s.Pop(); !ok {
+			t.Fatalf("expect pop to succeed on: %v", s)
+		}
+		n++
+	}
+	if n != 2 {
+		t.Errorf("expect 2 elements popped, but got: %d", n)
+	}
+	if _, ok := s.Pop(); ok {
+		t.Errorf("expect pop to fail on an empty set")
+	}
+}
+
+func TestHashSet_CollisionsWithItself(t *testing.T) {
+	// every element has the same hash, so removing one shifts the chain
+	// that an operation with the set itself is ranging over
+	newSet := func() *HashSet[string] {
+		return NewHashSet(func(string) uint64 { return 0 }, func(a, b string) bool { return a == b }, "a", "b", "c", "")
+	}
+	testcases := []struct {
+		name   string
+		op     func(h *HashSet[string])
+		expect []string
+	}{
+		{name: "union with", op: func(h *HashSet[string]) { h.UnionWith(h) }, expect: []string{"", "a", "b", "c"}},
+		{name: "difference with", op: func(h *HashSet[string]) { h.DifferenceWith(h) }, expect: []string{}},
+		{name: "intersect with", op: func(h *HashSet[string]) { h.IntersectWith(h) }, expect: []string{"", "a", "b", "c"}},
+		{name: "symmetric difference with", op: func(h *HashSet[string]) { h.SymmetricDifferenceWith(h) }, expect: []string{}},
+		{name: "difference with equal set", op: func(h *HashSet[string]) { h.DifferenceWith(newSet()) }, expect: []string{}},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		h := newSet()
+		tc.op(h)
+		if got := h.SortedList(func(i, j string) bool { return i < j }); fmt.Sprint(got) != fmt.Sprint(tc.expect) || h.Size() != len(tc.expect) {
+			t.Errorf("expect: %q, but got: %q (size: %d)", tc.expect, got, h.Size())
+		}
+	}
+}
+
+func TestHashSet_Operations(t *testing.T) {
+	names := func(s *HashSet[user]) []string {
+		v := s.SortedList(func(i, j user) bool {
+			return strings.ToLower(i.Name) < strings.ToLower(j.Name)
+		})
+		out := make([]string, len(v))
+		for i, u := range v {
+			out[i] = u.Name
+		}
+		return out
+	}
+	s := newUsers(user{Name: "a"}, user{Name: "b"}, user{Name: "c"})
+	u := newUsers(user{Name: "A"}, user{Name: "C"}, user{Name: "d"})
+	testcases := []struct {
+		name   string
+		result *HashSet[user]
+		expect []string
+	}{
+		{name: "union", result: s.Union(u), expect: []string{"a", "b", "c", "d"}},
+		{name: "difference", result: s.Difference(u), expect: []string{"b"}},
+		{name: "intersection", result: s.Intersection(u), expect: []string{"a", "c"}},
+		{name: "intersection keeps the receiver", result: u.Intersection(s), expect: []string{"A", "C"}},
+		{name: "symmetric difference", result: s.SymmetricDifference(u), expect: []string{"b", "d"}},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		if got := names(tc.result); fmt.Sprint(got) != fmt.Sprint(tc.expect) {
+			t.Errorf("expect: %v, but got: %v", tc.expect, got)
+		}
+	}
+
+	c := s.Copy()
+	c.UnionWith(u)
+	if got := names(c); fmt.Sprint(got) != "[a b c d]" {
+		t.Errorf("expect union with: [a b c d], but got: %v", got)
+	}
+	c.DifferenceWith(newUsers(user{Name: "B"}))
+	c.IntersectWith(newUsers(user{Name: "a"}, user{Name: "b"}, user{Name: "D"}))
+	if got := names(c); fmt.Sprint(got) != "[a d]" {
+		t.Errorf("expect: [a d], but got: %v", got)
+	}
+	c.SymmetricDifferenceWith(newUsers(user{Name: "d"}, user{Name: "e"}))
+	if got := names(c); fmt.Sprint(got) != "[a e]" {
+		t.Errorf("expect: [a e], but got: %v", got)
+	}
+	if names(s)[1] != "b" || s.Size() != 3 {
+		t.Errorf("expect the copied set unchanged, but got: %v", names(s))
+	}
+
+	if !newUsers(user{Name: "A"}).IsSubset(s) || s.IsSubset(u) || !s.IsSuperset(newUsers(user{Name: "C"})) {
+		t.Errorf("expect subset relations to ignore case")
+	}
+	if !s.Equal(newUsers(user{Name: "C"}, user{Name: "B"}, user{Name: "A"})) || s.Equal(u) {
+		t.Errorf("expect equality to ignore case")
+	}
+}
+
+func TestHashSet_Format(t *testing.T) {
+	s := newBytes([]byte("b"), []byte("a"))
+	testcases := []struct {
+		name   string
+		format string
+		expect string
+	}{
+		{name: "string", format: "%s", expect: "[[97], [98]]"},
+		{name: "quoted", format: "%q", expect: `["a", "b"]`},
+		{name: "go syntax", format: "%#v", expect: "set.NewHashSet[[]uint8](hash, equal, []byte{0x61}, []byte{0x62})"},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		if got := fmt.Sprintf(tc.format, s); got != tc.expect {
+			t.Errorf("expect: %s, but got: %s", tc.expect, got)
+		}
+	}
+}
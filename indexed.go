@@ -0,0 +1,398 @@
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package set
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// IndexedSet is a collection of values which contains no two values with
+// the same key, without any particular order. The key of a value is
+// extracted by the func given to NewIndexedSet, such as the ID of a User,
+// and the value is retained, so a set of Users unique by ID is:
+//
+//	s := set.NewIndexedSet(func(u User) int { return u.ID }, users...)
+//	u, ok := s.Get(1)
+//
+// The operations taking another IndexedSet compare the keys and carry the
+// values of the left operand, that is the receiver, for the keys which are
+// in both sets.
+// An IndexedSet must be created with NewIndexedSet.
+type IndexedSet[K comparable, V any] struct {
+	key func(V) K
+	m   map[K]V
+}
+
+// NewIndexedSet initializes a new IndexedSet which indexes the values by
+// key, it panics if key is nil.
+func NewIndexedSet[K comparable, V any](key func(V) K, values ...V) *IndexedSet[K, V] {
+	if key == nil {
+		panic("set: NewIndexedSet requires a key func")
+	}
+	s := &IndexedSet[K, V]{key: key, m: map[K]V{}}
+	s.Add(values...)
+	return s
+}
+
+// empty returns an empty IndexedSet with the key func of IndexedSet s.
+func (s *IndexedSet[K, V]) empty() *IndexedSet[K, V] {
+	return &IndexedSet[K, V]{key: s.key, m: map[K]V{}}
+}
+
+// Key returns the key of value.
+func (s *IndexedSet[K, V]) Key(value V) K {
+	return s.key(value)
+}
+
+// Add adds the values to IndexedSet, if no value with the same key is
+// present already, the value present is kept.
+func (s *IndexedSet[K, V]) Add(values ...V) {
+	for _, value := range values {
+		k := s.key(value)
+		if _, ok := s.m[k]; !ok {
+			s.m[k] = value
+		}
+	}
+}
+
+// Replace adds the values to IndexedSet, replacing the value with the same
+// key if it is present.
+func (s *IndexedSet[K, V]) Replace(values ...V) {
+	for _, value := range values {
+		s.m[s.key(value)] = value
+	}
+}
+
+// Get returns the value with the key. The second value is a bool that is
+// true if the value existed in the IndexedSet, and false if not.
+func (s *IndexedSet[K, V]) Get(key K) (V, bool) {
+	value, ok := s.m[key]
+	return value, ok
+}
+
+// Remove removes the values with the same key as the values from
+// IndexedSet, if it is present.
+func (s *IndexedSet[K, V]) Remove(values ...V) {
+	for _, value := range values {
+		delete(s.m, s.key(value))
+	}
+}
+
+// RemoveKey removes the values with the keys from IndexedSet, if it is
+// present.
+func (s *IndexedSet[K, V]) RemoveKey(keys ...K) {
+	for _, key := range keys {
+		delete(s.m, key)
+	}
+}
+
+// Pop returns an arbitrary value of IndexedSet, deleting it from
+// IndexedSet. The second value is a bool that is true if the values existed
+// in the IndexedSet, and false if not.
+func (s *IndexedSet[K, V]) Pop() (V, bool) {
+	for k, value := range s.m {
+		delete(s.m, k)
+		return value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Size returns the number of values in IndexedSet.
+func (s *IndexedSet[K, V]) Size() int {
+	return len(s.m)
+}
+
+// IsEmpty returns whether the IndexedSet is Empty.
+func (s *IndexedSet[K, V]) IsEmpty() bool {
+	return len(s.m) == 0
+}
+
+// Clear removes all items from the IndexedSet.
+func (s *IndexedSet[K, V]) Clear() {
+	s.m = map[K]V{}
+}
+
+// Has judges whether a value with the same key as the specified value
+// exists in the IndexedSet.
+// it returns true if existed, and false if not.
+func (s *IndexedSet[K, V]) Has(value V) bool {
+	return s.HasKey(s.key(value))
+}
+
+// HasKey judges the specified key whether exists in the IndexedSet.
+// it returns true if existed, and false if not.
+func (s *IndexedSet[K, V]) HasKey(key K) bool {
+	_, ok := s.m[key]
+	return ok
+}
+
+// HasAll looks for the keys of the specified values to judge
+// whether all of them exist in the IndexedSet.
+func (s *IndexedSet[K, V]) HasAll(values ...V) bool {
+	for _, value := range values {
+		if !s.Has(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// HasAny looks for the keys of the specified values to judge
+// whether at least one of them exists in the IndexedSet.
+func (s *IndexedSet[K, V]) HasAny(values ...V) bool {
+	for _, value := range values {
+		if s.Has(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// Keys returns the keys of the values as a Set.
+func (s *IndexedSet[K, V]) Keys() Set[K] {
+	keys := NewWithSize[K](len(s.m))
+	for k := range s.m {
+		keys[k] = struct{}{}
+	}
+	return keys
+}
+
+// List returns the all values as a slice, without any particular order.
+func (s *IndexedSet[K, V]) List() []V {
+	v := make([]V, 0, len(s.m))
+	for _, value := range s.m {
+		v = append(v, value)
+	}
+	return v
+}
+
+// SortedList returns the all values as a slice, sorted by less.
+func (s *IndexedSet[K, V]) SortedList(less func(i, j V) bool) []V {
+	v := s.List()
+	sort.Slice(v, func(i, j int) bool {
+		return less(v[i], v[j])
+	})
+	return v
+}
+
+// EachE traverses the values in the IndexedSet, calling do func for each
+// IndexedSet member. the cycle will be stopped when the do func returns
+// error.
+// if err is ErrBreakEach, break the cycle and return nil,
+// else, break the cycle and return error.
+func (s *IndexedSet[K, V]) EachE(do func(i V) error) error {
+	for _, value := range s.m {
+		if err := do(value); err != nil {
+			if err == ErrBreakEach {
+				return nil
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// Each traverses the values in the IndexedSet, calling do func for each
+// IndexedSet member.
+func (s *IndexedSet[K, V]) Each(do func(i V)) {
+	for _, value := range s.m {
+		do(value)
+	}
+}
+
+// combine returns a new IndexedSet which is op of IndexedSet s and t on the
+// keys, with the key func of s and the values of s for the keys in both.
+func (s *IndexedSet[K, V]) combine(t *IndexedSet[K, V], op int) *IndexedSet[K, V] {
+	u := s.empty()
+	for k, value := range s.m {
+		_, ok := t.m[k]
+		if ok == (op == opAnd) || op == opOr {
+			u.m[k] = value
+		}
+	}
+	if op == opOr || op == opXor {
+		for k, value := range t.m {
+			if _, ok := s.m[k]; !ok {
+				u.m[k] = value
+			}
+		}
+	}
+	return u
+}
+
+// Union returns the union of IndexedSet s and t, the values of s are kept
+// for the keys in both.
+// For example:
+// s = {1: a, 2: b}
+// t = {2: x, 3: y}
+// s.Union(t) = {1: a, 2: b, 3: y}
+// t.Union(s) = {1: a, 2: x, 3: y}
+func (s *IndexedSet[K, V]) Union(t *IndexedSet[K, V]) *IndexedSet[K, V] {
+	return s.combine(t, opOr)
+}
+
+// Difference returns the values of IndexedSet s whose keys are not in
+// IndexedSet t.
+// For example:
+// s = {1: a, 2: b}
+// t = {2: x, 3: y}
+// s.Difference(t) = {1: a}
+func (s *IndexedSet[K, V]) Difference(t *IndexedSet[K, V]) *IndexedSet[K, V] {
+	return s.combine(t, opAndNot)
+}
+
+// Intersection returns the values of IndexedSet s whose keys are in
+// IndexedSet t.
+// For example:
+// s = {1: a, 2: b}
+// t = {2: x, 3: y}
+// s.Intersection(t) = {2: b}
+// t.Intersection(s) = {2: x}
+func (s *IndexedSet[K, V]) Intersection(t *IndexedSet[K, V]) *IndexedSet[K, V] {
+	return s.combine(t, opAnd)
+}
+
+// SymmetricDifference returns a new IndexedSet with the values whose keys
+// are either in this IndexedSet or in the given IndexedSet, but not in both.
+// For example:
+// s = {1: a, 2: b}
+// t = {2: x, 3: y}
+// s.SymmetricDifference(t) = {1: a, 3: y}
+func (s *IndexedSet[K, V]) SymmetricDifference(t *IndexedSet[K, V]) *IndexedSet[K, V] {
+	return s.combine(t, opXor)
+}
+
+// UnionWith adds the values of IndexedSet t whose keys are not in
+// IndexedSet s to s.
+func (s *IndexedSet[K, V]) UnionWith(t *IndexedSet[K, V]) {
+	for k, value := range t.m {
+		if _, ok := s.m[k]; !ok {
+			s.m[k] = value
+		}
+	}
+}
+
+// DifferenceWith removes the values whose keys are in IndexedSet t from
+// IndexedSet s.
+func (s *IndexedSet[K, V]) DifferenceWith(t *IndexedSet[K, V]) {
+	for k := range t.m {
+		delete(s.m, k)
+	}
+}
+
+// IntersectWith removes the values of IndexedSet s whose keys are not in
+// IndexedSet t.
+func (s *IndexedSet[K, V]) IntersectWith(t *IndexedSet[K, V]) {
+	for k := range s.m {
+		if _, ok := t.m[k]; !ok {
+			delete(s.m, k)
+		}
+	}
+}
+
+// SymmetricDifferenceWith keeps the values whose keys are either in
+// IndexedSet s or in IndexedSet t, but not in both.
+func (s *IndexedSet[K, V]) SymmetricDifferenceWith(t *IndexedSet[K, V]) {
+	for k, value := range t.m {
+		if _, ok := s.m[k]; ok {
+			delete(s.m, k)
+		} else {
+			s.m[k] = value
+		}
+	}
+}
+
+// IsSubset predicates that tests whether the keys of IndexedSet s are a
+// subset of the keys of IndexedSet t.
+func (s *IndexedSet[K, V]) IsSubset(t *IndexedSet[K, V]) bool {
+	if len(s.m) > len(t.m) {
+		return false
+	}
+	for k := range s.m {
+		if _, ok := t.m[k]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSuperset predicates that tests whether the keys of IndexedSet s are a
+// superset of the keys of IndexedSet t.
+func (s *IndexedSet[K, V]) IsSuperset(t *IndexedSet[K, V]) bool {
+	return t.IsSubset(s)
+}
+
+// Equal predicates that tests whether IndexedSet s and t have the same
+// keys, the values are not compared.
+func (s *IndexedSet[K, V]) Equal(t *IndexedSet[K, V]) bool {
+	return len(s.m) == len(t.m) && s.IsSubset(t)
+}
+
+// Copy returns new IndexedSet that clones from IndexedSet, with the same
+// key func. The values are shared and not copied.
+func (s *IndexedSet[K, V]) Copy() *IndexedSet[K, V] {
+	u := &IndexedSet[K, V]{key: s.key, m: make(map[K]V, len(s.m))}
+	for k, value := range s.m {
+		u.m[k] = value
+	}
+	return u
+}
+
+// sorted returns the values in the natural order of their keys.
+func (s *IndexedSet[K, V]) sorted() []V {
+	keys := s.Keys().List()
+	sortElements(keys)
+	v := make([]V, len(keys))
+	for i, k := range keys {
+		v[i] = s.m[k]
+	}
+	return v
+}
+
+// String returns a string representation of IndexedSet, the values are in
+// the natural order of their keys.
+func (s *IndexedSet[K, V]) String() string {
+	return joinElements(s.sorted(), "%v")
+}
+
+// Format implements fmt.Formatter, the values are in the natural order of
+// their keys. See Set.Format for the verbs, the key func cannot be written
+// in Go syntax so %#v names it key.
+func (s *IndexedSet[K, V]) Format(f fmt.State, verb rune) {
+	v := s.sorted()
+	if verb == 'v' && f.Flag('#') {
+		args := []string{"key"}
+		for i := range v {
+			args = append(args, goSyntax(reflect.ValueOf(&v[i]).Elem()))
+		}
+		fmt.Fprintf(f, "set.NewIndexedSet[%s, %s](%s)", typeName[K](), typeName[V](), strings.Join(args, ", "))
+		return
+	}
+	formatElements(f, verb, v, "NewIndexedSet["+typeName[K]()+", "+typeName[V]()+"]")
+}
@@ -0,0 +1,152 @@
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package set
+
+import (
+	"fmt"
+	"testing"
+)
+
+type account struct {
+	ID   int
+	Name string
+}
+
+func accountID(a account) int {
+	return a.ID
+}
+
+func accountNames(s *IndexedSet[int, account]) string {
+	v := s.sorted()
+	out := make([]string, len(v))
+	for i, a := range v {
+		out[i] = fmt.Sprintf("%d:%s", a.ID, a.Name)
+	}
+	return fmt.Sprint(out)
+}
+
+func TestIndexedSet(t *testing.T) {
+	s := NewIndexedSet(accountID, account{ID: 1, Name: "a"}, account{ID: 2, Name: "b"}, account{ID: 1, Name: "x"})
+	if got := accountNames(s); got != "[1:a 2:b]" {
+		t.Errorf("expect the first value kept: [1:a 2:b], but got: %s", got)
+	}
+	if a, ok := s.Get(1); !ok || a.Name != "a" {
+		t.Errorf("expect get 1: {1 a}, but got: %v, %v", a, ok)
+	}
+	if _, ok := s.Get(3); ok {
+		t.Errorf("expect get 3 to fail")
+	}
+	s.Replace(account{ID: 1, Name: "y"}, account{ID: 3, Name: "c"})
+	if got := accountNames(s); got != "[1:y 2:b 3:c]" {
+		t.Errorf("expect replaced: [1:y 2:b 3:c], but got: %s", got)
+	}
+	if !s.Has(account{ID: 2}) || !s.HasKey(3) || s.HasAny(account{ID: 4}) || !s.HasAll(account{ID: 1}, account{ID: 2}) {
+		t.Errorf("expect lookups by key, but got: %v", s)
+	}
+	s.Remove(account{ID: 2, Name: "anything"})
+	s.RemoveKey(3)
+	if got := accountNames(s); got != "[1:y]" {
+		t.Errorf("expect: [1:y], but got: %s", got)
+	}
+	if !s.Keys().Equal(New(1)) {
+		t.Errorf("expect keys: [1], but got: %v", s.Keys())
+	}
+	if a, ok := s.Pop(); !ok || a.ID != 1 || !s.IsEmpty() {
+		t.Errorf("expect pop: {1 y}, but got: %v, %v", a, ok)
+	}
+	if _, ok := s.Pop(); ok {
+		t.Errorf("expect pop to fail on an empty set")
+	}
+}
+
+func TestIndexedSet_Operations(t *testing.T) {
+	s := NewIndexedSet(accountID, account{ID: 1, Name: "a"}, account{ID: 2, Name: "b"})
+	u := NewIndexedSet(accountID, account{ID: 2, Name: "x"}, account{ID: 3, Name: "y"})
+	testcases := []struct {
+		name   string
+		result *IndexedSet[int, account]
+		expect string
+	}{
+		{name: "union", result: s.Union(u), expect: "[1:a 2:b 3:y]"},
+		{name: "union from the right", result: u.Union(s), expect: "[1:a 2:x 3:y]"},
+		{name: "difference", result: s.Difference(u), expect: "[1:a]"},
+		{name: "intersection", result: s.Intersection(u), expect: "[2:b]"},
+		{name: "intersection from the right", result: u.Intersection(s), expect: "[2:x]"},
+		{name: "symmetric difference", result: s.SymmetricDifference(u), expect: "[1:a 3:y]"},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		if got := accountNames(tc.result); got != tc.expect {
+			t.Errorf("expect: %s, but got: %s", tc.expect, got)
+		}
+	}
+
+	c := s.Copy()
+	c.UnionWith(u)
+	if got := accountNames(c); got != "[1:a 2:b 3:y]" {
+		t.Errorf("expect union with: [1:a 2:b 3:y], but got: %s", got)
+	}
+	c.IntersectWith(u)
+	if got := accountNames(c); got != "[2:b 3:y]" {
+		t.Errorf("expect intersect with: [2:b 3:y], but got: %s", got)
+	}
+	c.SymmetricDifferenceWith(s)
+	if got := accountNames(c); got != "[1:a 3:y]" {
+		t.Errorf("expect symmetric difference with: [1:a 3:y], but got: %s", got)
+	}
+	c.DifferenceWith(u)
+	if got := accountNames(c); got != "[1:a]" {
+		t.Errorf("expect difference with: [1:a], but got: %s", got)
+	}
+	if got := accountNames(s); got != "[1:a 2:b]" {
+		t.Errorf("expect the copied set unchanged, but got: %s", got)
+	}
+
+	if !c.IsSubset(s) || s.IsSubset(c) || !s.IsSuperset(c) {
+		t.Errorf("expect subset relations on keys")
+	}
+	if !s.Equal(NewIndexedSet(accountID, account{ID: 2}, account{ID: 1})) || s.Equal(u) {
+		t.Errorf("expect equality on keys")
+	}
+}
+
+func TestIndexedSet_Format(t *testing.T) {
+	s := NewIndexedSet(accountID, account{ID: 2, Name: "b"}, account{ID: 1, Name: "a"})
+	testcases := []struct {
+		name   string
+		format string
+		expect string
+	}{
+		{name: "default", format: "%v", expect: "[{1 a}, {2 b}]"},
+		{name: "size", format: "%+v", expect: "[{ID:1 Name:a}, {ID:2 Name:b}] (size: 2)"},
+		{name: "go syntax", format: "%#v", expect: `set.NewIndexedSet[int, set.account](key, set.account{ID:1, Name:"a"}, set.account{ID:2, Name:"b"})`},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		if got := fmt.Sprintf(tc.format, s); got != tc.expect {
+			t.Errorf("expect: %s, but got: %s", tc.expect, got)
+		}
+	}
+}
@@ -0,0 +1,226 @@
+//go:build go1.23
+
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package set
+
+import "iter"
+
+// All returns an iterator over the elements of Set, without any particular
+// order, for use with range:
+//
+//	for element := range s.All() {
+//		...
+//	}
+//
+// As with range over the map, elements added during the iteration may or
+// may not be produced, elements removed before they are reached are not.
+func (s Set[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for k := range s {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Sorted returns an iterator over the elements of Set in their natural
+// order, it iterates a sorted snapshot taken when the iteration starts.
+func (s Set[T]) Sorted() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		v := s.List()
+		sortElements(v)
+		yieldElements(v, yield)
+	}
+}
+
+// Collect initializes a new Set with the elements produced by seq.
+func Collect[T comparable](seq iter.Seq[T]) Set[T] {
+	s := Set[T]{}
+	for element := range seq {
+		s[element] = struct{}{}
+	}
+	return s
+}
+
+// All returns an iterator over a snapshot of the elements in the Sync, the
+// lock is not held while the loop body runs, as for Each.
+func (s *Sync[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		yieldElements(s.List(), yield)
+	}
+}
+
+// Sorted returns an iterator over a snapshot of the elements in the Sync in
+// their natural order.
+func (s *Sync[T]) Sorted() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		v := s.List()
+		sortElements(v)
+		yieldElements(v, yield)
+	}
+}
+
+// CollectSync initializes a new Sync with the elements produced by seq.
+func CollectSync[T comparable](seq iter.Seq[T]) *Sync[T] {
+	return &Sync[T]{s: Collect(seq)}
+}
+
+// All returns an iterator over the elements in the Sharded, each shard is
+// iterated on a snapshot, as for EachE.
+func (s *Sharded[T]) All() iter.Seq[T] {
+	return seqOf(s.EachE)
+}
+
+// Sorted returns an iterator over a snapshot of the elements in the Sharded
+// in their natural order.
+func (s *Sharded[T]) Sorted() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		v := s.List()
+		sortOrdered(v)
+		yieldElements(v, yield)
+	}
+}
+
+// CollectSharded initializes a new Sharded with the elements produced by seq.
+func CollectSharded[T Ordered](seq iter.Seq[T]) *Sharded[T] {
+	s := NewSharded[T]()
+	for element := range seq {
+		s.Add(element)
+	}
+	return s
+}
+
+// All returns an iterator over the elements in the Bitset in ascending order.
+func (b *Bitset[T]) All() iter.Seq[T] {
+	return seqOf(b.EachE)
+}
+
+// Sorted returns an iterator over the elements in the Bitset in ascending
+// order, it is the same as All.
+func (b *Bitset[T]) Sorted() iter.Seq[T] {
+	return b.All()
+}
+
+// CollectBitset initializes a new Bitset with the elements produced by seq.
+func CollectBitset[T Small](seq iter.Seq[T]) *Bitset[T] {
+	b := NewBitset[T]()
+	for element := range seq {
+		b.Add(element)
+	}
+	return b
+}
+
+// All returns an iterator over the elements in the Roaring in ascending order.
+func (r *Roaring[T]) All() iter.Seq[T] {
+	return seqOf(r.EachE)
+}
+
+// Sorted returns an iterator over the elements in the Roaring in ascending
+// order, it is the same as All.
+func (r *Roaring[T]) Sorted() iter.Seq[T] {
+	return r.All()
+}
+
+// CollectRoaring initializes a new Roaring with the elements produced by seq.
+func CollectRoaring[T Wide](seq iter.Seq[T]) *Roaring[T] {
+	r := NewRoaring[T]()
+	for element := range seq {
+		r.Add(element)
+	}
+	return r
+}
+
+// All returns an iterator over the elements in the OrderedSet in ascending
+// order.
+func (o *OrderedSet[T]) All() iter.Seq[T] {
+	return seqOf(o.EachE)
+}
+
+// Sorted returns an iterator over the elements in the OrderedSet in
+// ascending order, it is the same as All.
+func (o *OrderedSet[T]) Sorted() iter.Seq[T] {
+	return o.All()
+}
+
+// CollectOrderedSet initializes a new OrderedSet with the elements produced
+// by seq.
+func CollectOrderedSet[T Ordered](seq iter.Seq[T]) *OrderedSet[T] {
+	o := NewOrderedSet[T]()
+	for element := range seq {
+		o.Add(element)
+	}
+	return o
+}
+
+// All returns an iterator over the elements in the LinkedSet in insertion
+// order. Elements removed, added or moved during the iteration are handled
+// as for Each.
+func (l *LinkedSet[T]) All() iter.Seq[T] {
+	return seqOf(l.EachE)
+}
+
+// Sorted returns an iterator over a snapshot of the elements in the
+// LinkedSet in their natural order, rather than in insertion order.
+func (l *LinkedSet[T]) Sorted() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		v := l.List()
+		sortElements(v)
+		yieldElements(v, yield)
+	}
+}
+
+// CollectLinkedSet initializes a new LinkedSet with the elements produced by
+// seq, in the order they are produced.
+func CollectLinkedSet[T comparable](seq iter.Seq[T]) *LinkedSet[T] {
+	l := NewLinkedSet[T]()
+	for element := range seq {
+		l.Add(element)
+	}
+	return l
+}
+
+// seqOf adapts an EachE traversal to an iterator, it breaks the traversal
+// with ErrBreakEach when the loop stops.
+func seqOf[T any](eachE func(do func(i T) error) error) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		_ = eachE(func(i T) error {
+			if !yield(i) {
+				return ErrBreakEach
+			}
+			return nil
+		})
+	}
+}
+
+// yieldElements yields the elements until yield returns false.
+func yieldElements[T any](elements []T, yield func(T) bool) {
+	for _, element := range elements {
+		if !yield(element) {
+			return
+		}
+	}
+}
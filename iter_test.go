@@ -0,0 +1,167 @@
+//go:build go1.23
+
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package set
+
+import (
+	"iter"
+	"maps"
+	"slices"
+	"testing"
+)
+
+// sequences are the iterators shared by the set types.
+type sequences[T any] interface {
+	All() iter.Seq[T]
+	Sorted() iter.Seq[T]
+}
+
+func TestSet_All(t *testing.T) {
+	testcases := []struct {
+		name   string
+		s      Int
+		expect []int
+	}{
+		{name: "nil", s: nil, expect: nil},
+		{name: "empty", s: NewInt(), expect: nil},
+		{name: "elements", s: NewInt(3, 1, 2), expect: []int{1, 2, 3}},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		if got := slices.Sorted(tc.s.All()); !slices.Equal(got, tc.expect) {
+			t.Errorf("expect elements: %v, but got: %v", tc.expect, got)
+		}
+		if got := slices.Collect(tc.s.Sorted()); !slices.Equal(got, tc.expect) {
+			t.Errorf("expect sorted elements: %v, but got: %v", tc.expect, got)
+		}
+	}
+}
+
+func TestSet_AllBreak(t *testing.T) {
+	elements := []uint32{5, 1, 4, 2, 3}
+	testcases := []struct {
+		name   string
+		s      sequences[uint32]
+		first  []uint32
+		sorted []uint32
+	}{
+		{name: "Set", s: New(elements...), sorted: []uint32{1, 2}},
+		{name: "Sync", s: NewSync(elements...), sorted: []uint32{1, 2}},
+		{name: "Sharded", s: NewSharded(elements...), sorted: []uint32{1, 2}},
+		{name: "Roaring", s: NewRoaring(elements...), first: []uint32{1, 2}, sorted: []uint32{1, 2}},
+		{name: "OrderedSet", s: NewOrderedSet(elements...), first: []uint32{1, 2}, sorted: []uint32{1, 2}},
+		{name: "LinkedSet", s: NewLinkedSet(elements...), first: []uint32{5, 1}, sorted: []uint32{1, 2}},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		var first []uint32
+		for element := range tc.s.All() {
+			first = append(first, element)
+			if len(first) == 2 {
+				break
+			}
+		}
+		if len(first) != 2 || tc.first != nil && !slices.Equal(first, tc.first) {
+			t.Errorf("expect first elements: %v, but got: %v", tc.first, first)
+		}
+		var sorted []uint32
+		for element := range tc.s.Sorted() {
+			sorted = append(sorted, element)
+			if len(sorted) == 2 {
+				break
+			}
+		}
+		if !slices.Equal(sorted, tc.sorted) {
+			t.Errorf("expect sorted elements: %v, but got: %v", tc.sorted, sorted)
+		}
+		if got := slices.Sorted(tc.s.All()); !slices.Equal(got, []uint32{1, 2, 3, 4, 5}) {
+			t.Errorf("expect elements: [1 2 3 4 5], but got: %v", got)
+		}
+	}
+
+	b := NewBitset[uint8](5, 1, 4)
+	if got := slices.Collect(b.All()); !slices.Equal(got, []uint8{1, 4, 5}) {
+		t.Errorf("expect elements: [1 4 5], but got: %v", got)
+	}
+	if got := slices.Collect(b.Sorted()); !slices.Equal(got, []uint8{1, 4, 5}) {
+		t.Errorf("expect sorted elements: [1 4 5], but got: %v", got)
+	}
+}
+
+func TestSet_AllMutation(t *testing.T) {
+	s := NewSync(1, 2, 3)
+	for element := range s.All() {
+		// the lock is not held, so the loop may modify the Sync
+		s.Remove(element)
+		s.Add(element + 10)
+	}
+	validateSet(t, s.Snapshot(), []int{11, 12, 13})
+
+	l := NewLinkedSet(1, 2, 3)
+	var got []int
+	for element := range l.All() {
+		got = append(got, element)
+		if element == 1 {
+			l.Remove(2)
+			l.Add(4)
+		}
+	}
+	if !slices.Equal(got, []int{1, 3, 4}) {
+		t.Errorf("expect elements: [1 3 4], but got: %v", got)
+	}
+}
+
+func TestCollect(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2}
+	validateSet(t, Collect(maps.Keys(m)), []string{"a", "b"})
+	validateSet(t, Collect(maps.Values(m)), []int{1, 2})
+	validateSet(t, Collect(slices.Values([]int{1, 1, 2})), []int{1, 2})
+	if s := Collect(NewInt().All()); s == nil || s.Size() != 0 {
+		t.Errorf("expect empty non nil set, but got: %v", s)
+	}
+
+	seq := slices.Values([]uint16{3, 1, 3, 2})
+	validateSet(t, CollectSync(seq).Snapshot(), []uint16{1, 2, 3})
+	validateSet(t, CollectSharded(seq).Snapshot(), []uint16{1, 2, 3})
+	if got := slices.Collect(CollectBitset(seq).All()); !slices.Equal(got, []uint16{1, 2, 3}) {
+		t.Errorf("expect bitset: [1 2 3], but got: %v", got)
+	}
+	if got := slices.Collect(CollectOrderedSet(seq).All()); !slices.Equal(got, []uint16{1, 2, 3}) {
+		t.Errorf("expect ordered set: [1 2 3], but got: %v", got)
+	}
+	if got := slices.Collect(CollectLinkedSet(seq).All()); !slices.Equal(got, []uint16{3, 1, 2}) {
+		t.Errorf("expect linked set: [3 1 2], but got: %v", got)
+	}
+	r := CollectRoaring(slices.Values([]uint32{1 << 20, 1, 1 << 20}))
+	if got := slices.Collect(r.All()); !slices.Equal(got, []uint32{1, 1 << 20}) {
+		t.Errorf("expect roaring: [1 1048576], but got: %v", got)
+	}
+
+	s := NewString("b", "a")
+	if got := slices.Collect(CollectLinkedSet(s.Sorted()).All()); !slices.Equal(got, []string{"a", "b"}) {
+		t.Errorf("expect linked set in sorted order: [a b], but got: %v", got)
+	}
+}
@@ -0,0 +1,170 @@
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package set
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// ErrDuplicateElement is returned when decoding a Set from input which
+// contains the same element more than once and duplicates are rejected.
+var ErrDuplicateElement = errors.New("duplicate element")
+
+// JSONOptions configures how EncodeJSON and DecodeJSON convert between a Set
+// and a JSON array.
+type JSONOptions struct {
+	// Sorted sorts the elements of the encoded array in their natural order,
+	// so that the same Set always encodes to the same bytes.
+	Sorted bool
+	// RejectDuplicates makes decoding fail with ErrDuplicateElement if the
+	// array holds an element more than once, by default duplicates are merged.
+	RejectDuplicates bool
+}
+
+// MarshalJSON implements json.Marshaler, it encodes the Set as a JSON array
+// sorted in the natural order of the elements. A nil Set encodes as null.
+func (s Set[T]) MarshalJSON() ([]byte, error) {
+	return EncodeJSON(s, JSONOptions{Sorted: true})
+}
+
+// UnmarshalJSON implements json.Unmarshaler, it replaces the Set with the
+// elements of a JSON array, merging duplicate elements. null decodes to a nil Set.
+//
+// Interface sets only accept JSON scalars, numbers decode to float64 as
+// they do with encoding/json. Arrays and objects decode to slices and maps,
+// which are not hashable, so they are rejected with an error rather than
+// panicking when added to the Set.
+func (s *Set[T]) UnmarshalJSON(data []byte) error {
+	return DecodeJSON(data, s, JSONOptions{})
+}
+
+// EncodeJSON returns the JSON array encoding of Set s configured by opts.
+func EncodeJSON[T comparable](s Set[T], opts JSONOptions) ([]byte, error) {
+	if s == nil {
+		return []byte("null"), nil
+	}
+	v := s.List()
+	if v == nil {
+		v = []T{}
+	}
+	if opts.Sorted {
+		sortElements(v)
+	}
+	return marshalElements(v)
+}
+
+// marshalElements returns the JSON array encoding of the elements.
+func marshalElements[T any](v []T) ([]byte, error) {
+	if reflect.TypeOf(v).Elem().Kind() == reflect.Uint8 {
+		// encoding/json encodes byte slices as base64 strings, box the
+		// elements so that each one encodes as a number
+		boxed := make([]interface{}, len(v))
+		for i, element := range v {
+			boxed[i] = element
+		}
+		return json.Marshal(boxed)
+	}
+	return json.Marshal(v)
+}
+
+// DecodeJSON replaces Set s with the elements of the JSON array in data,
+// configured by opts.
+func DecodeJSON[T comparable](data []byte, s *Set[T], opts JSONOptions) error {
+	v, err := unmarshalElements[T](data)
+	if err != nil {
+		return err
+	}
+	if v == nil {
+		*s = nil
+		return nil
+	}
+	u := NewWithSize[T](len(v))
+	for i, element := range v {
+		if opts.RejectDuplicates && u.Has(element) {
+			return fmt.Errorf("set: JSON array element %d %v: %w", i, element, ErrDuplicateElement)
+		}
+		u[element] = struct{}{}
+	}
+	*s = u
+	return nil
+}
+
+// unmarshalElements returns the elements of the JSON array in data, it
+// returns an error if an element is not hashable, so that it can be added
+// to a map without panicking.
+func unmarshalElements[T comparable](data []byte) ([]T, error) {
+	var v []T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, err
+	}
+	if i, ok := unhashableElement(v); ok {
+		return nil, fmt.Errorf("set: JSON array element %d of type %T is not hashable", i, v[i])
+	}
+	return v, nil
+}
+
+// unhashableElement returns the index of the first element which is not
+// hashable, elements decoded into an interface may hold slices or maps.
+func unhashableElement[T comparable](v []T) (int, bool) {
+	if !holdsInterface(reflect.TypeOf((*T)(nil)).Elem()) {
+		return 0, false
+	}
+	for i := range v {
+		if !hashable(reflect.ValueOf(&v[i]).Elem()) {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// holdsInterface reports whether values of type t may hold interfaces, whose
+// dynamic values need to be checked by hashable.
+func holdsInterface(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Interface:
+		return true
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if holdsInterface(t.Field(i).Type) {
+				return true
+			}
+		}
+		return false
+	case reflect.Array:
+		return holdsInterface(t.Elem())
+	default:
+		return false
+	}
+}
+
+// hashable reports whether v can be used as a map key without panicking,
+// it looks into the dynamic values held by interfaces, structs and arrays.
+func hashable(v reflect.Value) bool {
+	_, _, ok := findUnhashable(v, "")
+	return !ok
+}
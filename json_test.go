@@ -0,0 +1,225 @@
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package set
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestSet_MarshalJSON(t *testing.T) {
+	testcases := []struct {
+		name   string
+		s      interface{}
+		expect string
+	}{
+		{
+			name:   "test Int MarshalJSON, s is nil",
+			s:      Int(nil),
+			expect: `null`,
+		},
+		{
+			name:   "test Int MarshalJSON, s is empty",
+			s:      NewInt(),
+			expect: `[]`,
+		},
+		{
+			name:   "test Int MarshalJSON, s is sorted",
+			s:      NewInt(3, -1, 2, 10),
+			expect: `[-1,2,3,10]`,
+		},
+		{
+			name:   "test String MarshalJSON, s is sorted",
+			s:      NewString("b", "c", "a"),
+			expect: `["a","b","c"]`,
+		},
+		{
+			name:   "test Interface MarshalJSON, s is sorted by type and value",
+			s:      NewInterface("b", 2, true, "a", 1),
+			expect: `[true,1,2,"a","b"]`,
+		},
+		{
+			name:   "test Int MarshalJSON, s is a struct field",
+			s:      struct{ Tags Uint8 }{Tags: NewUint8(2, 1)},
+			expect: `{"Tags":[1,2]}`,
+		},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		actual, err := json.Marshal(tc.s)
+		if err != nil {
+			t.Errorf("expect no error, but got: %v", err)
+			continue
+		}
+		if string(actual) != tc.expect {
+			t.Errorf("expect json: %s, but got: %s", tc.expect, actual)
+		}
+	}
+}
+
+func TestSet_UnmarshalJSON(t *testing.T) {
+	testcases := []struct {
+		name      string
+		input     string
+		expect    []int
+		expectNil bool
+		expectErr bool
+	}{
+		{
+			name:      "test Int UnmarshalJSON, input is null",
+			input:     `null`,
+			expectNil: true,
+		},
+		{
+			name:   "test Int UnmarshalJSON, input is empty",
+			input:  `[]`,
+			expect: []int{},
+		},
+		{
+			name:   "test Int UnmarshalJSON, input has duplicate elements",
+			input:  `[1, 2, 2, 3]`,
+			expect: []int{1, 2, 3},
+		},
+		{
+			name:      "test Int UnmarshalJSON, input is not an array",
+			input:     `{"1": {}}`,
+			expectErr: true,
+		},
+		{
+			name:      "test Int UnmarshalJSON, input has invalid element",
+			input:     `[1, "2"]`,
+			expectErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		actual := NewInt(9)
+		err := json.Unmarshal([]byte(tc.input), &actual)
+		if (err != nil) != tc.expectErr {
+			t.Errorf("expect error: %v, but got: %v", tc.expectErr, err)
+			continue
+		}
+		if tc.expectErr {
+			continue
+		}
+		if tc.expectNil {
+			if actual != nil {
+				t.Errorf("expect nil set, but got: %s", actual)
+			}
+			continue
+		}
+		validateSet(t, actual, tc.expect)
+	}
+}
+
+func TestEncodeJSON(t *testing.T) {
+	s := NewString("x", "y", "z")
+	data, err := EncodeJSON(s, JSONOptions{})
+	if err != nil {
+		t.Fatalf("expect no error, but got: %v", err)
+	}
+	var actual String
+	if err := json.Unmarshal(data, &actual); err != nil {
+		t.Fatalf("expect no error, but got: %v", err)
+	}
+	validateSet(t, actual, []string{"x", "y", "z"})
+}
+
+func TestDecodeJSON(t *testing.T) {
+	testcases := []struct {
+		name      string
+		input     string
+		opts      JSONOptions
+		expect    []interface{}
+		expectErr error
+	}{
+		{
+			name:   "test Interface DecodeJSON, input has scalars",
+			input:  `[1, "a", true, null, 1.0]`,
+			expect: []interface{}{1.0, "a", true, nil},
+		},
+		{
+			name:   "test Interface DecodeJSON, merge duplicates",
+			input:  `["a", "a"]`,
+			expect: []interface{}{"a"},
+		},
+		{
+			name:      "test Interface DecodeJSON, reject duplicates",
+			input:     `["a", "b", "a"]`,
+			opts:      JSONOptions{RejectDuplicates: true},
+			expectErr: ErrDuplicateElement,
+		},
+		{
+			name:      "test Interface DecodeJSON, input has an array",
+			input:     `[1, [2]]`,
+			expectErr: errors.New("set: JSON array element 1 of type []interface {} is not hashable"),
+		},
+		{
+			name:      "test Interface DecodeJSON, input has an object",
+			input:     `[{"a": 1}]`,
+			expectErr: errors.New("set: JSON array element 0 of type map[string]interface {} is not hashable"),
+		},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		var actual Interface
+		err := DecodeJSON([]byte(tc.input), &actual, tc.opts)
+		if tc.expectErr != nil {
+			if err == nil || (!errors.Is(err, tc.expectErr) && err.Error() != tc.expectErr.Error()) {
+				t.Errorf("expect error: %v, but got: %v", tc.expectErr, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("expect no error, but got: %v", err)
+			continue
+		}
+		validateSet(t, actual, tc.expect)
+	}
+}
+
+func TestDecodeJSON_Struct(t *testing.T) {
+	type element struct {
+		Name  string
+		Value interface{}
+	}
+	var s Set[element]
+	if err := DecodeJSON([]byte(`[{"Name": "a", "Value": 1}]`), &s, JSONOptions{}); err != nil {
+		t.Errorf("expect no error, but got: %v", err)
+	}
+	validateSet(t, s, []element{{Name: "a", Value: 1.0}})
+	if err := DecodeJSON([]byte(`[{"Name": "a", "Value": [1]}]`), &s, JSONOptions{}); err == nil {
+		t.Errorf("expect error for nested unhashable value, but got nil")
+	}
+}
+
+func TestSet_JSONUint8(t *testing.T) {
+	var actual Uint8
+	if err := json.Unmarshal([]byte(`[1, 255, 1]`), &actual); err != nil {
+		t.Fatalf("expect no error, but got: %v", err)
+	}
+	validateSet(t, actual, []uint8{1, 255})
+}
@@ -0,0 +1,524 @@
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package set
+
+import (
+	"fmt"
+	"sort"
+)
+
+// LinkedSet is a collection of T that contains no duplicate elements and
+// remembers the order in which the elements were added: the elements are
+// indexed by a map and linked in a doubly linked list, so that Add, Remove
+// and Has are O(1) and List, String and Each follow the insertion order,
+// which is the same between runs.
+// Adding an element which is present already does not change its position.
+// The zero value is an empty LinkedSet ready to use.
+type LinkedSet[T comparable] struct {
+	m           map[T]*entry[T]
+	first, last *entry[T]
+	// traversal numbers the calls of EachE.
+	traversal uint64
+	// pushed numbers the entries appended to the list.
+	pushed uint64
+}
+
+// entry is an element of LinkedSet in the linked list.
+type entry[T comparable] struct {
+	value      T
+	prev, next *entry[T]
+	// visited is the traversal which visited the entry last.
+	visited uint64
+	// seq is the order of the entry in the list, it increases from the
+	// first entry to the last.
+	seq uint64
+}
+
+// NewLinkedSet initializes a new LinkedSet with the elements in order.
+func NewLinkedSet[T comparable](elements ...T) *LinkedSet[T] {
+	l := NewLinkedSetWithSize[T](len(elements))
+	l.Add(elements...)
+	return l
+}
+
+// NewLinkedSetWithSize initializes a new LinkedSet with the capacity.
+func NewLinkedSetWithSize[T comparable](size int) *LinkedSet[T] {
+	return &LinkedSet[T]{m: make(map[T]*entry[T], size)}
+}
+
+// ToSet returns a Set with the elements of LinkedSet.
+func (l *LinkedSet[T]) ToSet() Set[T] {
+	s := NewWithSize[T](l.Size())
+	for e := l.first; e != nil; e = e.next {
+		s[e.value] = struct{}{}
+	}
+	return s
+}
+
+// push appends the entry to the end of the list.
+func (l *LinkedSet[T]) push(e *entry[T]) {
+	l.pushed++
+	e.prev, e.next, e.seq = l.last, nil, l.pushed
+	if l.last == nil {
+		l.first = e
+	} else {
+		l.last.next = e
+	}
+	l.last = e
+}
+
+// unlink removes the entry from the list, it keeps e.next so that Each can
+// carry on after the current element was removed.
+func (l *LinkedSet[T]) unlink(e *entry[T]) {
+	if e.prev == nil {
+		l.first = e.next
+	} else {
+		e.prev.next = e.next
+	}
+	if e.next == nil {
+		l.last = e.prev
+	} else {
+		e.next.prev = e.prev
+	}
+}
+
+// removed reports whether the entry was removed from the LinkedSet, Remove
+// marks it by linking its prev to itself.
+func (e *entry[T]) removed() bool {
+	return e.prev == e
+}
+
+// successor returns the entry which follows e in the list, or nil. If e was
+// removed, its next leads back to the list, unless e was the last entry: the
+// entries pushed since then follow it.
+func (l *LinkedSet[T]) successor(e *entry[T]) *entry[T] {
+	if !e.removed() {
+		return e.next
+	}
+	for ; e.next != nil; e = e.next {
+		if !e.next.removed() {
+			return e.next
+		}
+	}
+	n := l.last
+	if n == nil || n.seq < e.seq {
+		return nil
+	}
+	for n.prev != nil && n.prev.seq > e.seq {
+		n = n.prev
+	}
+	return n
+}
+
+// Add adds the elements to the end of LinkedSet, if it is not present already.
+func (l *LinkedSet[T]) Add(elements ...T) {
+	if l.m == nil {
+		l.m = make(map[T]*entry[T], len(elements))
+	}
+	for _, element := range elements {
+		if _, ok := l.m[element]; ok {
+			continue
+		}
+		e := &entry[T]{value: element}
+		l.m[element] = e
+		l.push(e)
+	}
+}
+
+// Remove removes the element from LinkedSet, if it is present.
+func (l *LinkedSet[T]) Remove(elements ...T) {
+	for _, element := range elements {
+		if e, ok := l.m[element]; ok {
+			delete(l.m, element)
+			l.unlink(e)
+			e.prev = e
+		}
+	}
+}
+
+// MoveToEnd moves the element to the end of LinkedSet, as if it was removed
+// and added again. It returns false if the element is not present.
+// During Each, the element is visited at its new position unless it was
+// visited already.
+func (l *LinkedSet[T]) MoveToEnd(element T) bool {
+	e, ok := l.m[element]
+	if !ok {
+		return false
+	}
+	if e != l.last {
+		// the entry is removed as Remove does, so that Each carries on from
+		// its old position, and a new entry takes its place at the end
+		l.unlink(e)
+		e.prev = e
+		moved := &entry[T]{value: element, visited: e.visited}
+		l.m[element] = moved
+		l.push(moved)
+	}
+	return true
+}
+
+// First returns the element which was added first.
+// The second value is false if the LinkedSet is empty.
+func (l *LinkedSet[T]) First() (T, bool) {
+	var zero T
+	if l.first == nil {
+		return zero, false
+	}
+	return l.first.value, true
+}
+
+// Last returns the element which was added last.
+// The second value is false if the LinkedSet is empty.
+func (l *LinkedSet[T]) Last() (T, bool) {
+	var zero T
+	if l.last == nil {
+		return zero, false
+	}
+	return l.last.value, true
+}
+
+// Pop returns the element which was added first, deleting it from LinkedSet.
+// The second value is a bool that is true if the elements existed in
+// the LinkedSet, and false if not.
+func (l *LinkedSet[T]) Pop() (T, bool) {
+	element, ok := l.First()
+	if ok {
+		l.Remove(element)
+	}
+	return element, ok
+}
+
+// Size returns the number of elements in LinkedSet.
+func (l *LinkedSet[T]) Size() int {
+	return len(l.m)
+}
+
+// IsEmpty returns whether the LinkedSet is Empty.
+func (l *LinkedSet[T]) IsEmpty() bool {
+	return len(l.m) == 0
+}
+
+// Clear removes all items from the LinkedSet.
+func (l *LinkedSet[T]) Clear() {
+	// mark the entries removed, so that Each does not visit them
+	for e := l.first; e != nil; e = e.next {
+		e.prev = e
+	}
+	l.m, l.first, l.last = nil, nil, nil
+}
+
+// Has judges the specified element whether exists in the LinkedSet.
+// it returns true if existed, and false if not.
+func (l *LinkedSet[T]) Has(element T) bool {
+	_, ok := l.m[element]
+	return ok
+}
+
+// HasAll looks for the specified elements to judge
+// whether all exist in the LinkedSet.
+// it returns true if existed, and false if not.
+func (l *LinkedSet[T]) HasAll(elements ...T) bool {
+	for _, element := range elements {
+		if !l.Has(element) {
+			return false
+		}
+	}
+	return true
+}
+
+// HasAny looks for the specified elements to judge
+// whether at least one of the element exists in the LinkedSet.
+// it returns true if existed, and false if not.
+func (l *LinkedSet[T]) HasAny(elements ...T) bool {
+	for _, element := range elements {
+		if l.Has(element) {
+			return true
+		}
+	}
+	return false
+}
+
+// List returns the all elements as a slice in insertion order.
+func (l *LinkedSet[T]) List() []T {
+	var dest []T
+	if n := l.Size(); n > 0 {
+		dest = make([]T, 0, n)
+	}
+	for e := l.first; e != nil; e = e.next {
+		dest = append(dest, e.value)
+	}
+	return dest
+}
+
+// SortedList returns the all elements as a slice sorted by less func.
+func (l *LinkedSet[T]) SortedList(less func(i, j T) bool) []T {
+	dest := l.List()
+	sort.Slice(dest, func(i, j int) bool {
+		return less(dest[i], dest[j])
+	})
+	return dest
+}
+
+// EachE traverses the elements in the LinkedSet in insertion order, calling
+// do func for each LinkedSet member. the cycle will be stopped when the do
+// func returns error. if err is ErrBreakEach, break the cycle and return nil,
+// else, break the cycle and return error.
+// The do func may remove elements or clear the LinkedSet, the elements
+// removed are not visited afterwards, may add elements, which are visited
+// as well, even after the current element was removed, and may move elements with
+// MoveToEnd, which are visited at their new position unless they were
+// visited already, so every element is visited once.
+func (l *LinkedSet[T]) EachE(do func(i T) error) error {
+	l.traversal++
+	traversal := l.traversal
+	for e := l.first; e != nil; {
+		e.visited = traversal
+		if err := do(e.value); err != nil {
+			if err == ErrBreakEach {
+				return nil
+			}
+			return err
+		}
+		// skip the entries visited already which do moved to the end
+		e = l.successor(e)
+		for e != nil && e.visited == traversal {
+			e = e.next
+		}
+	}
+	return nil
+}
+
+// Each traverses the elements in the LinkedSet in insertion order, calling
+// do func for each LinkedSet member.
+// The do func may remove, add and move elements as described in EachE.
+func (l *LinkedSet[T]) Each(do func(i T)) {
+	l.EachE(func(i T) error {
+		do(i)
+		return nil
+	})
+}
+
+// Filter returns a new LinkedSet with the elements of LinkedSet l for which pred
+// returns true, in insertion order.
+func (l *LinkedSet[T]) Filter(pred func(i T) bool) *LinkedSet[T] {
+	in, _ := partitionElements(l.Each, pred)
+	return NewLinkedSet(in...)
+}
+
+// Partition returns two new LinkedSets, the first with the elements of LinkedSet l
+// for which pred returns true, the second with the others.
+func (l *LinkedSet[T]) Partition(pred func(i T) bool) (*LinkedSet[T], *LinkedSet[T]) {
+	in, out := partitionElements(l.Each, pred)
+	return NewLinkedSet(in...), NewLinkedSet(out...)
+}
+
+// Any reports whether pred returns true for any element of LinkedSet l, the
+// traversal stops at the first such element.
+func (l *LinkedSet[T]) Any(pred func(i T) bool) bool {
+	_, ok := findElement(l.EachE, pred)
+	return ok
+}
+
+// Every reports whether pred returns true for every element of LinkedSet l,
+// the traversal stops at the first element for which pred returns false.
+func (l *LinkedSet[T]) Every(pred func(i T) bool) bool {
+	_, ok := findElement(l.EachE, func(i T) bool {
+		return !pred(i)
+	})
+	return !ok
+}
+
+// Find returns the first element in insertion order of LinkedSet l for which
+// pred returns true. The second value is false if there is no such element.
+func (l *LinkedSet[T]) Find(pred func(i T) bool) (T, bool) {
+	return findElement(l.EachE, pred)
+}
+
+// Count returns the number of elements of LinkedSet l for which pred returns
+// true.
+func (l *LinkedSet[T]) Count(pred func(i T) bool) int {
+	return countElements(l.Each, pred)
+}
+
+// Union returns the union of LinkedSet l and s, the elements of l in their
+// order followed by the other elements of s in their order.
+func (l *LinkedSet[T]) Union(s *LinkedSet[T]) *LinkedSet[T] {
+	u := l.Copy()
+	u.UnionWith(s)
+	return u
+}
+
+// Difference returns the difference of LinkedSet l and s, in the order of l.
+func (l *LinkedSet[T]) Difference(s *LinkedSet[T]) *LinkedSet[T] {
+	u := NewLinkedSetWithSize[T](l.Size())
+	for e := l.first; e != nil; e = e.next {
+		if !s.Has(e.value) {
+			u.Add(e.value)
+		}
+	}
+	return u
+}
+
+// Intersection returns the intersection of LinkedSet l and s, in the order of l.
+func (l *LinkedSet[T]) Intersection(s *LinkedSet[T]) *LinkedSet[T] {
+	u := NewLinkedSet[T]()
+	for e := l.first; e != nil; e = e.next {
+		if s.Has(e.value) {
+			u.Add(e.value)
+		}
+	}
+	return u
+}
+
+// SymmetricDifference returns a new LinkedSet with the elements that are either in this LinkedSet
+// or in the given LinkedSet, but not in both, the elements of l in their order followed by those
+// of s in their order.
+func (l *LinkedSet[T]) SymmetricDifference(s *LinkedSet[T]) *LinkedSet[T] {
+	u := l.Difference(s)
+	for e := s.first; e != nil; e = e.next {
+		if !l.Has(e.value) {
+			u.Add(e.value)
+		}
+	}
+	return u
+}
+
+// UnionWith appends the elements of LinkedSet s which are not in LinkedSet l,
+// in the order of s.
+func (l *LinkedSet[T]) UnionWith(s *LinkedSet[T]) {
+	for e := s.first; e != nil; e = e.next {
+		l.Add(e.value)
+	}
+}
+
+// DifferenceWith removes all elements of LinkedSet s from LinkedSet l.
+func (l *LinkedSet[T]) DifferenceWith(s *LinkedSet[T]) {
+	for e := l.first; e != nil; e = e.next {
+		if s.Has(e.value) {
+			l.Remove(e.value)
+		}
+	}
+}
+
+// IntersectWith removes the elements of LinkedSet l which are not in LinkedSet s.
+func (l *LinkedSet[T]) IntersectWith(s *LinkedSet[T]) {
+	for e := l.first; e != nil; e = e.next {
+		if !s.Has(e.value) {
+			l.Remove(e.value)
+		}
+	}
+}
+
+// SymmetricDifferenceWith keeps the elements that are either in LinkedSet l or in
+// LinkedSet s, but not in both, the elements of s which are not in l are appended.
+func (l *LinkedSet[T]) SymmetricDifferenceWith(s *LinkedSet[T]) {
+	*l = *l.SymmetricDifference(s)
+}
+
+// IsSubset predicates that tests whether the LinkedSet l is a subset of LinkedSet s.
+func (l *LinkedSet[T]) IsSubset(s *LinkedSet[T]) bool {
+	if l.Size() > s.Size() {
+		return false
+	}
+	for e := l.first; e != nil; e = e.next {
+		if !s.Has(e.value) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSuperset predicates that tests whether the LinkedSet l is a super of LinkedSet s.
+func (l *LinkedSet[T]) IsSuperset(s *LinkedSet[T]) bool {
+	return s.IsSubset(l)
+}
+
+// Equal predicates that tests whether the LinkedSet l equals of LinkedSet s,
+// regardless of the order of the elements.
+func (l *LinkedSet[T]) Equal(s *LinkedSet[T]) bool {
+	return l.Size() == s.Size() && l.IsSubset(s)
+}
+
+// Copy returns new LinkedSet that clones from LinkedSet, in the same order.
+func (l *LinkedSet[T]) Copy() *LinkedSet[T] {
+	u := NewLinkedSetWithSize[T](l.Size())
+	for e := l.first; e != nil; e = e.next {
+		u.Add(e.value)
+	}
+	return u
+}
+
+// String returns a string representation of LinkedSet in insertion order.
+func (l *LinkedSet[T]) String() string {
+	return joinElements(l.List(), "%v")
+}
+
+// Format implements fmt.Formatter, the elements are in insertion order.
+// See Set.Format for the verbs.
+func (l *LinkedSet[T]) Format(f fmt.State, verb rune) {
+	formatElements(f, verb, l.List(), "NewLinkedSet["+typeName[T]()+"]")
+}
+
+// MarshalJSON implements json.Marshaler, it encodes the LinkedSet as a JSON
+// array in insertion order.
+func (l *LinkedSet[T]) MarshalJSON() ([]byte, error) {
+	v := l.List()
+	if v == nil {
+		v = []T{}
+	}
+	return marshalElements(v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, it replaces the LinkedSet with
+// the elements of a JSON array in the order of the array, merging duplicate
+// elements. Interface elements are checked as Set.UnmarshalJSON does.
+func (l *LinkedSet[T]) UnmarshalJSON(data []byte) error {
+	v, err := unmarshalElements[T](data)
+	if err != nil {
+		return err
+	}
+	l.Clear()
+	l.Add(v...)
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler, it encodes the LinkedSet as a
+// sequence in insertion order.
+func (l *LinkedSet[T]) MarshalYAML() (interface{}, error) {
+	return yamlElements(l.List()), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, it replaces the LinkedSet with
+// the elements of a sequence in the order of the sequence, merging duplicate
+// elements. Interface elements are checked as Set.UnmarshalYAML does.
+func (l *LinkedSet[T]) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	v, err := unmarshalYAMLElements[T](unmarshal)
+	if err != nil {
+		return err
+	}
+	l.Clear()
+	l.Add(v...)
+	return nil
+}
@@ -0,0 +1,423 @@
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package set
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// validateOrder checks the elements of LinkedSet and their order.
+func validateOrder[T comparable](t *testing.T, actual *LinkedSet[T], expect []T) {
+	t.Helper()
+	if list := actual.List(); !reflect.DeepEqual(list, expect) || actual.Size() != len(expect) {
+		t.Errorf("expect list: %v, but got: %v", expect, list)
+	}
+	var back []T
+	for e := actual.last; e != nil; e = e.prev {
+		back = append(back, e.value)
+	}
+	for i := range back {
+		if back[i] != expect[len(expect)-1-i] {
+			t.Errorf("expect backward list of: %v, but got: %v", expect, back)
+			break
+		}
+	}
+}
+
+func TestLinkedSet(t *testing.T) {
+	testcases := []struct {
+		name   string
+		do     func() *LinkedSet[int]
+		expect []int
+	}{
+		{
+			name:   "new",
+			do:     func() *LinkedSet[int] { return NewLinkedSet(3, 1, 2, 1, 3) },
+			expect: []int{3, 1, 2},
+		},
+		{
+			name: "add present element keeps its position",
+			do: func() *LinkedSet[int] {
+				l := NewLinkedSet(3, 1, 2)
+				l.Add(1, 4)
+				return l
+			},
+			expect: []int{3, 1, 2, 4},
+		},
+		{
+			name: "remove",
+			do: func() *LinkedSet[int] {
+				l := NewLinkedSet(3, 1, 2, 4)
+				l.Remove(3, 2, 5)
+				return l
+			},
+			expect: []int{1, 4},
+		},
+		{
+			name: "remove and add again",
+			do: func() *LinkedSet[int] {
+				l := NewLinkedSet(3, 1, 2)
+				l.Remove(3)
+				l.Add(3)
+				return l
+			},
+			expect: []int{1, 2, 3},
+		},
+		{
+			name: "move to end",
+			do: func() *LinkedSet[int] {
+				l := NewLinkedSet(3, 1, 2)
+				l.MoveToEnd(3)
+				l.MoveToEnd(1)
+				l.MoveToEnd(1)
+				l.MoveToEnd(5)
+				return l
+			},
+			expect: []int{2, 3, 1},
+		},
+		{
+			name: "pop",
+			do: func() *LinkedSet[int] {
+				l := NewLinkedSet(3, 1, 2)
+				l.Pop()
+				return l
+			},
+			expect: []int{1, 2},
+		},
+		{
+			name:   "union",
+			do:     func() *LinkedSet[int] { return NewLinkedSet(3, 1, 2).Union(NewLinkedSet(5, 2, 4)) },
+			expect: []int{3, 1, 2, 5, 4},
+		},
+		{
+			name:   "difference",
+			do:     func() *LinkedSet[int] { return NewLinkedSet(3, 1, 2, 5).Difference(NewLinkedSet(5, 1)) },
+			expect: []int{3, 2},
+		},
+		{
+			name:   "intersection",
+			do:     func() *LinkedSet[int] { return NewLinkedSet(3, 1, 2, 5).Intersection(NewLinkedSet(5, 3, 4)) },
+			expect: []int{3, 5},
+		},
+		{
+			name:   "symmetric difference",
+			do:     func() *LinkedSet[int] { return NewLinkedSet(3, 1, 2).SymmetricDifference(NewLinkedSet(5, 2, 4)) },
+			expect: []int{3, 1, 5, 4},
+		},
+		{
+			name: "union with",
+			do: func() *LinkedSet[int] {
+				l := NewLinkedSet(3, 1, 2)
+				l.UnionWith(NewLinkedSet(5, 2, 4))
+				return l
+			},
+			expect: []int{3, 1, 2, 5, 4},
+		},
+		{
+			name: "difference with",
+			do: func() *LinkedSet[int] {
+				l := NewLinkedSet(3, 1, 2, 5)
+				l.DifferenceWith(NewLinkedSet(5, 3))
+				return l
+			},
+			expect: []int{1, 2},
+		},
+		{
+			name: "intersect with",
+			do: func() *LinkedSet[int] {
+				l := NewLinkedSet(3, 1, 2, 5)
+				l.IntersectWith(NewLinkedSet(5, 3))
+				return l
+			},
+			expect: []int{3, 5},
+		},
+		{
+			name: "symmetric difference with",
+			do: func() *LinkedSet[int] {
+				l := NewLinkedSet(3, 1, 2)
+				l.SymmetricDifferenceWith(NewLinkedSet(5, 2, 4))
+				return l
+			},
+			expect: []int{3, 1, 5, 4},
+		},
+		{
+			name:   "copy",
+			do:     func() *LinkedSet[int] { return NewLinkedSet(3, 1, 2).Copy() },
+			expect: []int{3, 1, 2},
+		},
+		{
+			name: "clear",
+			do: func() *LinkedSet[int] {
+				l := NewLinkedSet(3, 1, 2)
+				l.Clear()
+				l.Add(4)
+				return l
+			},
+			expect: []int{4},
+		},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		validateOrder(t, tc.do(), tc.expect)
+	}
+}
+
+func TestLinkedSet_Predicates(t *testing.T) {
+	l := NewLinkedSet("a", "b", "c")
+	if !l.Has("a") || l.Has("d") || !l.HasAll("a", "c") || l.HasAll("a", "d") || !l.HasAny("d", "b") || l.HasAny("d") {
+		t.Errorf("unexpected predicates on: %s", l)
+	}
+	if !l.Equal(NewLinkedSet("c", "b", "a")) || l.Equal(NewLinkedSet("a", "b")) {
+		t.Errorf("expect Equal to ignore the order of: %s", l)
+	}
+	if !NewLinkedSet("c", "a").IsSubset(l) || l.IsSubset(NewLinkedSet("a")) || !l.IsSuperset(NewLinkedSet("b")) {
+		t.Errorf("unexpected subset predicates on: %s", l)
+	}
+	if first, ok := l.First(); !ok || first != "a" {
+		t.Errorf("expect first: a, but got: %s", first)
+	}
+	if last, ok := l.Last(); !ok || last != "c" {
+		t.Errorf("expect last: c, but got: %s", last)
+	}
+	if l.String() != "[a, b, c]" {
+		t.Errorf("expect [a, b, c], but got: %s", l)
+	}
+	validateSet(t, l.ToSet(), []string{"a", "b", "c"})
+}
+
+func TestLinkedSet_Zero(t *testing.T) {
+	var l LinkedSet[string]
+	if !l.IsEmpty() || l.Has("") || l.Size() != 0 || l.String() != "[]" || l.List() != nil {
+		t.Errorf("expect zero LinkedSet to be empty")
+	}
+	if _, ok := l.First(); ok {
+		t.Errorf("expect First on zero LinkedSet to fail")
+	}
+	if _, ok := l.Last(); ok {
+		t.Errorf("expect Last on zero LinkedSet to fail")
+	}
+	if _, ok := l.Pop(); ok {
+		t.Errorf("expect Pop on zero LinkedSet to fail")
+	}
+	l.Remove("a")
+	l.Add("b", "a")
+	validateOrder(t, &l, []string{"b", "a"})
+}
+
+func TestLinkedSet_Each(t *testing.T) {
+	testcases := []struct {
+		name    string
+		do      func(l *LinkedSet[int], i int)
+		visited []int
+		expect  []int
+	}{
+		{
+			name:    "visit in order",
+			do:      func(l *LinkedSet[int], i int) {},
+			visited: []int{5, 1, 4, 2},
+			expect:  []int{5, 1, 4, 2},
+		},
+		{
+			name:    "remove current",
+			do:      func(l *LinkedSet[int], i int) { l.Remove(i) },
+			visited: []int{5, 1, 4, 2},
+			expect:  nil,
+		},
+		{
+			name: "remove next",
+			do: func(l *LinkedSet[int], i int) {
+				if i == 5 {
+					l.Remove(1, 4)
+				}
+			},
+			visited: []int{5, 2},
+			expect:  []int{5, 2},
+		},
+		{
+			name: "remove current and next",
+			do: func(l *LinkedSet[int], i int) {
+				if i == 1 {
+					l.Remove(1, 4)
+				}
+			},
+			visited: []int{5, 1, 2},
+			expect:  []int{5, 2},
+		},
+		{
+			name: "add",
+			do: func(l *LinkedSet[int], i int) {
+				if i < 3 {
+					l.Add(i + 10)
+				}
+			},
+			visited: []int{5, 1, 4, 2, 11, 12},
+			expect:  []int{5, 1, 4, 2, 11, 12},
+		},
+		{
+			name: "move current",
+			do: func(l *LinkedSet[int], i int) {
+				if i == 1 {
+					l.MoveToEnd(i)
+				}
+			},
+			visited: []int{5, 1, 4, 2},
+			expect:  []int{5, 4, 2, 1},
+		},
+		{
+			name:    "move every current",
+			do:      func(l *LinkedSet[int], i int) { l.MoveToEnd(i) },
+			visited: []int{5, 1, 4, 2},
+			expect:  []int{5, 1, 4, 2},
+		},
+		{
+			name: "move visited",
+			do: func(l *LinkedSet[int], i int) {
+				if i == 4 {
+					l.MoveToEnd(5)
+				}
+			},
+			visited: []int{5, 1, 4, 2},
+			expect:  []int{1, 4, 2, 5},
+		},
+		{
+			name: "move next",
+			do: func(l *LinkedSet[int], i int) {
+				if i == 5 {
+					l.MoveToEnd(1)
+					l.MoveToEnd(4)
+				}
+			},
+			visited: []int{5, 2, 1, 4},
+			expect:  []int{5, 2, 1, 4},
+		},
+		{
+			name: "move and remove",
+			do: func(l *LinkedSet[int], i int) {
+				if i == 1 {
+					l.MoveToEnd(1)
+					l.Remove(4)
+				}
+			},
+			visited: []int{5, 1, 2},
+			expect:  []int{5, 2, 1},
+		},
+		{
+			name: "remove last and add",
+			do: func(l *LinkedSet[int], i int) {
+				if i == 2 {
+					l.Remove(2)
+					l.Add(3)
+				}
+			},
+			visited: []int{5, 1, 4, 2, 3},
+			expect:  []int{5, 1, 4, 3},
+		},
+		{
+			name: "remove the tail twice and add",
+			do: func(l *LinkedSet[int], i int) {
+				if i == 4 {
+					l.Remove(4, 2)
+					l.Add(3)
+				}
+			},
+			visited: []int{5, 1, 4, 3},
+			expect:  []int{5, 1, 3},
+		},
+		{
+			name: "clear",
+			do: func(l *LinkedSet[int], i int) {
+				if i == 1 {
+					l.Clear()
+				}
+			},
+			visited: []int{5, 1},
+			expect:  nil,
+		},
+		{
+			name: "clear and add",
+			do: func(l *LinkedSet[int], i int) {
+				if i == 1 {
+					l.Clear()
+					l.Add(7, 5)
+				}
+			},
+			visited: []int{5, 1, 7, 5},
+			expect:  []int{7, 5},
+		},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		l := NewLinkedSet(5, 1, 4, 2)
+		var visited []int
+		l.Each(func(i int) {
+			visited = append(visited, i)
+			tc.do(l, i)
+		})
+		if !reflect.DeepEqual(visited, tc.visited) {
+			t.Errorf("expect visited: %v, but got: %v", tc.visited, visited)
+		}
+		validateOrder(t, l, tc.expect)
+	}
+
+	var visited []int
+	expect := errors.New("stop")
+	err := NewLinkedSet(5, 1, 4).EachE(func(i int) error {
+		visited = append(visited, i)
+		if i == 1 {
+			return expect
+		}
+		return nil
+	})
+	if err != expect || !reflect.DeepEqual(visited, []int{5, 1}) {
+		t.Errorf("expect visited: [5 1] and error: %v, but got: %v and %v", expect, visited, err)
+	}
+	visited = nil
+	if err := NewLinkedSet(5, 1, 4).EachE(func(i int) error {
+		visited = append(visited, i)
+		return ErrBreakEach
+	}); err != nil || !reflect.DeepEqual(visited, []int{5}) {
+		t.Errorf("expect visited: [5], but got: %v and %v", visited, err)
+	}
+}
+
+func TestLinkedSet_JSON(t *testing.T) {
+	l := NewLinkedSet[uint8](3, 255, 1)
+	data, err := json.Marshal(l)
+	if err != nil || string(data) != "[3,255,1]" {
+		t.Fatalf("expect json: [3,255,1], but got: %s, %v", data, err)
+	}
+	c := NewLinkedSet[uint8](9)
+	if err := json.Unmarshal([]byte("[2, 1, 2, 3]"), c); err != nil {
+		t.Fatalf("expect no error, but got: %v", err)
+	}
+	validateOrder(t, c, []uint8{2, 1, 3})
+	var i LinkedSet[interface{}]
+	if err := json.Unmarshal([]byte(`[1, [2]]`), &i); err == nil {
+		t.Errorf("expect error on unhashable element, but got: %s", &i)
+	}
+}
@@ -0,0 +1,152 @@
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package set
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// Ordered is a constraint that permits the numeric and string types, that
+// is any type supporting the < operator.
+type Ordered interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64 |
+		~string
+}
+
+// sortElements sorts the elements in their natural order: numbers by value
+// with NaN first, strings lexically and false before true. Other elements,
+// and elements of different types held by an Interface, are ordered by their
+// type name and then by their Go-syntax representation, so the order is
+// stable between runs.
+func sortElements[T comparable](elements []T) {
+	switch v := interface{}(elements).(type) {
+	case []int:
+		sortOrdered(v)
+	case []int8:
+		sortOrdered(v)
+	case []int16:
+		sortOrdered(v)
+	case []int32:
+		sortOrdered(v)
+	case []int64:
+		sortOrdered(v)
+	case []uint:
+		sortOrdered(v)
+	case []uint8:
+		sortOrdered(v)
+	case []uint16:
+		sortOrdered(v)
+	case []uint32:
+		sortOrdered(v)
+	case []uint64:
+		sortOrdered(v)
+	case []uintptr:
+		sortOrdered(v)
+	case []float32:
+		sortOrdered(v)
+	case []float64:
+		sortOrdered(v)
+	case []string:
+		sortOrdered(v)
+	default:
+		sort.Slice(elements, func(i, j int) bool {
+			return compareAny(elements[i], elements[j]) < 0
+		})
+	}
+}
+
+func sortOrdered[T Ordered](elements []T) {
+	sort.Slice(elements, func(i, j int) bool {
+		return lessOrdered(elements[i], elements[j])
+	})
+}
+
+// lessOrdered reports whether a is less than b, NaN is less than any other
+// value so that floats have a total order.
+func lessOrdered[T Ordered](a, b T) bool {
+	return a < b || (a != a && b == b)
+}
+
+// compareAny returns -1, 0 or +1 depending on whether a is less than, equal
+// to or greater than b in the order described by sortElements.
+func compareAny(a, b interface{}) int {
+	if a == nil || b == nil {
+		switch {
+		case a == nil && b == nil:
+			return 0
+		case a == nil:
+			return -1
+		default:
+			return 1
+		}
+	}
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	if va.Type() != vb.Type() {
+		if c := compareOrdered(va.Type().String(), vb.Type().String()); c != 0 {
+			return c
+		}
+		return compareOrdered(va.Type().PkgPath(), vb.Type().PkgPath())
+	}
+	switch va.Kind() {
+	case reflect.Bool:
+		return compareBool(va.Bool(), vb.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return compareOrdered(va.Int(), vb.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return compareOrdered(va.Uint(), vb.Uint())
+	case reflect.Float32, reflect.Float64:
+		return compareOrdered(va.Float(), vb.Float())
+	case reflect.String:
+		return compareOrdered(va.String(), vb.String())
+	default:
+		return compareOrdered(fmt.Sprintf("%#v", a), fmt.Sprintf("%#v", b))
+	}
+}
+
+func compareOrdered[T Ordered](a, b T) int {
+	switch {
+	case lessOrdered(a, b):
+		return -1
+	case lessOrdered(b, a):
+		return 1
+	default:
+		return 0
+	}
+}
+
+func compareBool(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case !a:
+		return -1
+	default:
+		return 1
+	}
+}
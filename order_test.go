@@ -0,0 +1,98 @@
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package set
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestSortElements(t *testing.T) {
+	type named int
+	testcases := []struct {
+		name   string
+		input  interface{}
+		expect string
+	}{
+		{
+			name:   "ints",
+			input:  []int{3, -1, 2},
+			expect: "[-1 2 3]",
+		},
+		{
+			name:   "floats, NaN first",
+			input:  []float64{2, math.NaN(), -1, math.Inf(1)},
+			expect: "[NaN -1 2 +Inf]",
+		},
+		{
+			name:   "strings",
+			input:  []string{"b", "a", "ab"},
+			expect: "[a ab b]",
+		},
+		{
+			name:   "named ints",
+			input:  []named{3, 1, 2},
+			expect: "[1 2 3]",
+		},
+		{
+			name:   "bools",
+			input:  []bool{true, false},
+			expect: "[false true]",
+		},
+		{
+			name:   "interfaces, by type and then by value",
+			input:  []interface{}{"b", 2, nil, int8(1), "a", 1, false},
+			expect: "[<nil> false 1 2 1 a b]",
+		},
+		{
+			name:   "structs, by representation",
+			input:  []struct{ A int }{{2}, {1}},
+			expect: "[{1} {2}]",
+		},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		switch v := tc.input.(type) {
+		case []int:
+			sortElements(v)
+		case []float64:
+			sortElements(v)
+		case []string:
+			sortElements(v)
+		case []named:
+			sortElements(v)
+		case []bool:
+			sortElements(v)
+		case []interface{}:
+			sortElements(v)
+		case []struct{ A int }:
+			sortElements(v)
+		}
+		if actual := fmt.Sprint(tc.input); actual != tc.expect {
+			t.Errorf("expect order: %s, but got: %s", tc.expect, actual)
+		}
+	}
+}
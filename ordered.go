@@ -0,0 +1,703 @@
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package set
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// OrderedSet is a collection of T that contains no duplicate elements, kept
+// in ascending order in a balanced binary search tree (a treap), so that it
+// answers ordered queries such as Min, Floor or Range in O(log n) and
+// traverses the elements in ascending order without sorting them.
+// Floats are ordered with NaN before every other value, NaN is equal to
+// itself and -0 is equal to +0, so that they have a total order.
+// The zero value is an empty OrderedSet ready to use.
+type OrderedSet[T Ordered] struct {
+	root *node[T]
+	// seed is the state of the generator of the node priorities.
+	seed uint64
+	// version is incremented by the changes, for the fail-fast iterators.
+	version uint64
+}
+
+// node is a node of the treap, the tree is a binary search tree on the
+// values and a heap on the priorities.
+type node[T Ordered] struct {
+	value       T
+	priority    uint64
+	size        int
+	left, right *node[T]
+}
+
+func (n *node[T]) len() int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+func (n *node[T]) update() *node[T] {
+	n.size = 1 + n.left.len() + n.right.len()
+	return n
+}
+
+// NewOrderedSet initializes a new OrderedSet.
+func NewOrderedSet[T Ordered](elements ...T) *OrderedSet[T] {
+	o := &OrderedSet[T]{}
+	o.Add(elements...)
+	return o
+}
+
+// NewOrderedSetFromSet initializes a new OrderedSet with the elements of Set s.
+// The NaNs that a Set may hold several times are merged into one.
+func NewOrderedSetFromSet[T Ordered](s Set[T]) *OrderedSet[T] {
+	v := s.List()
+	sortOrdered(v)
+	// only NaN keys can be equal in the order but distinct in the map
+	n := 0
+	for i := range v {
+		if i == 0 || compareOrdered(v[n-1], v[i]) != 0 {
+			v[n] = v[i]
+			n++
+		}
+	}
+	v = v[:n]
+	o := &OrderedSet[T]{}
+	o.root = o.build(v)
+	return o
+}
+
+// ToSet returns a Set with the elements of OrderedSet.
+func (o *OrderedSet[T]) ToSet() Set[T] {
+	s := NewWithSize[T](o.Size())
+	o.Each(func(i T) {
+		s[i] = struct{}{}
+	})
+	return s
+}
+
+// priority returns the priority of a new node, it is a xorshift generator
+// rather than math/rand so that OrderedSet does not share a lock.
+func (o *OrderedSet[T]) priority() uint64 {
+	if o.seed == 0 {
+		o.seed = 0x9e3779b97f4a7c15
+	}
+	o.seed ^= o.seed << 13
+	o.seed ^= o.seed >> 7
+	o.seed ^= o.seed << 17
+	return o.seed
+}
+
+// build returns a treap with the elements, which must be sorted without
+// duplicates, in O(n): the right spine of the tree is kept in a stack and
+// each element is attached below the last node of higher priority.
+func (o *OrderedSet[T]) build(elements []T) *node[T] {
+	var spine []*node[T]
+	for _, element := range elements {
+		n := &node[T]{value: element, priority: o.priority(), size: 1}
+		var last *node[T]
+		for len(spine) > 0 && spine[len(spine)-1].priority < n.priority {
+			last = spine[len(spine)-1].update()
+			spine = spine[:len(spine)-1]
+		}
+		n.left = last
+		if len(spine) > 0 {
+			spine[len(spine)-1].right = n
+		}
+		spine = append(spine, n)
+	}
+	if len(spine) == 0 {
+		return nil
+	}
+	var root *node[T]
+	for i := len(spine) - 1; i >= 0; i-- {
+		root = spine[i]
+		root.update()
+	}
+	return root
+}
+
+// split splits the tree into the nodes less than value, and the others.
+func (n *node[T]) split(value T) (*node[T], *node[T]) {
+	if n == nil {
+		return nil, nil
+	}
+	if lessOrdered(n.value, value) {
+		l, r := n.right.split(value)
+		n.right = l
+		return n.update(), r
+	}
+	l, r := n.left.split(value)
+	n.left = r
+	return l, n.update()
+}
+
+// merge joins two trees, all the values of n must be less than those of r.
+func (n *node[T]) merge(r *node[T]) *node[T] {
+	switch {
+	case n == nil:
+		return r
+	case r == nil:
+		return n
+	case n.priority > r.priority:
+		n.right = n.right.merge(r)
+		return n.update()
+	default:
+		r.left = n.merge(r.left)
+		return r.update()
+	}
+}
+
+// insert adds the node x, whose value must not be in the tree.
+func (n *node[T]) insert(x *node[T]) *node[T] {
+	if n == nil {
+		return x
+	}
+	if x.priority > n.priority {
+		x.left, x.right = n.split(x.value)
+		return x.update()
+	}
+	if lessOrdered(x.value, n.value) {
+		n.left = n.left.insert(x)
+	} else {
+		n.right = n.right.insert(x)
+	}
+	return n.update()
+}
+
+// remove deletes the node of value.
+func (n *node[T]) remove(value T) *node[T] {
+	if n == nil {
+		return nil
+	}
+	switch compareOrdered(value, n.value) {
+	case -1:
+		n.left = n.left.remove(value)
+	case 1:
+		n.right = n.right.remove(value)
+	default:
+		return n.left.merge(n.right)
+	}
+	return n.update()
+}
+
+// each traverses the tree in order until do returns false, it returns false
+// if the traversal was stopped.
+func (n *node[T]) each(do func(i T) bool) bool {
+	for n != nil {
+		if !n.left.each(do) || !do(n.value) {
+			return false
+		}
+		n = n.right
+	}
+	return true
+}
+
+func (n *node[T]) clone() *node[T] {
+	if n == nil {
+		return nil
+	}
+	c := *n
+	c.left, c.right = n.left.clone(), n.right.clone()
+	return &c
+}
+
+// Add adds the elements to OrderedSet, if it is not present already.
+func (o *OrderedSet[T]) Add(elements ...T) {
+	for _, element := range elements {
+		if !o.Has(element) {
+			o.root = o.root.insert(&node[T]{value: element, priority: o.priority(), size: 1})
+			o.version++
+		}
+	}
+}
+
+// Remove removes the element from OrderedSet, if it is present.
+func (o *OrderedSet[T]) Remove(elements ...T) {
+	for _, element := range elements {
+		if o.Has(element) {
+			o.root = o.root.remove(element)
+			o.version++
+		}
+	}
+}
+
+// Pop returns the smallest element of OrderedSet, deleting it from OrderedSet.
+// The second value is a bool that is true if the elements existed in
+// the OrderedSet, and false if not.
+func (o *OrderedSet[T]) Pop() (T, bool) {
+	element, ok := o.Min()
+	if ok {
+		o.root = o.root.remove(element)
+		o.version++
+	}
+	return element, ok
+}
+
+// Size returns the number of elements in OrderedSet.
+func (o *OrderedSet[T]) Size() int {
+	return o.root.len()
+}
+
+// IsEmpty returns whether the OrderedSet is Empty.
+func (o *OrderedSet[T]) IsEmpty() bool {
+	return o.root == nil
+}
+
+// Clear removes all items from the OrderedSet.
+func (o *OrderedSet[T]) Clear() {
+	o.root = nil
+	o.version++
+}
+
+// Has judges the specified element whether exists in the OrderedSet.
+// it returns true if existed, and false if not.
+func (o *OrderedSet[T]) Has(element T) bool {
+	for n := o.root; n != nil; {
+		switch compareOrdered(element, n.value) {
+		case -1:
+			n = n.left
+		case 1:
+			n = n.right
+		default:
+			return true
+		}
+	}
+	return false
+}
+
+// HasAll looks for the specified elements to judge
+// whether all exist in the OrderedSet.
+// it returns true if existed, and false if not.
+func (o *OrderedSet[T]) HasAll(elements ...T) bool {
+	for _, element := range elements {
+		if !o.Has(element) {
+			return false
+		}
+	}
+	return true
+}
+
+// HasAny looks for the specified elements to judge
+// whether at least one of the element exists in the OrderedSet.
+// it returns true if existed, and false if not.
+func (o *OrderedSet[T]) HasAny(elements ...T) bool {
+	for _, element := range elements {
+		if o.Has(element) {
+			return true
+		}
+	}
+	return false
+}
+
+// Min returns the smallest element of OrderedSet.
+// The second value is false if the OrderedSet is empty.
+func (o *OrderedSet[T]) Min() (T, bool) {
+	var zero T
+	n := o.root
+	if n == nil {
+		return zero, false
+	}
+	for n.left != nil {
+		n = n.left
+	}
+	return n.value, true
+}
+
+// Max returns the largest element of OrderedSet.
+// The second value is false if the OrderedSet is empty.
+func (o *OrderedSet[T]) Max() (T, bool) {
+	var zero T
+	n := o.root
+	if n == nil {
+		return zero, false
+	}
+	for n.right != nil {
+		n = n.right
+	}
+	return n.value, true
+}
+
+// higher returns the smallest element of OrderedSet which is greater than
+// element. The second value is false if there is no such element.
+func (o *OrderedSet[T]) higher(element T) (T, bool) {
+	var higher T
+	var ok bool
+	for n := o.root; n != nil; {
+		if !lessOrdered(element, n.value) {
+			n = n.right
+			continue
+		}
+		higher, ok = n.value, true
+		n = n.left
+	}
+	return higher, ok
+}
+
+// Floor returns the largest element of OrderedSet which is less than or
+// equal to element. The second value is false if there is no such element.
+func (o *OrderedSet[T]) Floor(element T) (T, bool) {
+	var floor T
+	var ok bool
+	for n := o.root; n != nil; {
+		if lessOrdered(element, n.value) {
+			n = n.left
+			continue
+		}
+		floor, ok = n.value, true
+		n = n.right
+	}
+	return floor, ok
+}
+
+// Ceiling returns the smallest element of OrderedSet which is greater than or
+// equal to element. The second value is false if there is no such element.
+func (o *OrderedSet[T]) Ceiling(element T) (T, bool) {
+	var ceiling T
+	var ok bool
+	for n := o.root; n != nil; {
+		if lessOrdered(n.value, element) {
+			n = n.right
+			continue
+		}
+		ceiling, ok = n.value, true
+		n = n.left
+	}
+	return ceiling, ok
+}
+
+// Range returns the elements which are greater than or equal to lo and less
+// than hi, in ascending order.
+// For example:
+//
+//	NewOrderedSet(1, 2, 3, 4).Range(2, 4) // [2 3]
+func (o *OrderedSet[T]) Range(lo, hi T) []T {
+	var dest []T
+	var walk func(n *node[T])
+	walk = func(n *node[T]) {
+		for n != nil {
+			if lessOrdered(n.value, lo) {
+				n = n.right
+				continue
+			}
+			walk(n.left)
+			if !lessOrdered(n.value, hi) {
+				return
+			}
+			dest = append(dest, n.value)
+			n = n.right
+		}
+	}
+	walk(o.root)
+	return dest
+}
+
+// Rank returns the number of elements of OrderedSet which are less than
+// element, which is the index of element in List if it is present.
+func (o *OrderedSet[T]) Rank(element T) int {
+	rank := 0
+	for n := o.root; n != nil; {
+		if lessOrdered(n.value, element) {
+			rank += n.left.len() + 1
+			n = n.right
+		} else {
+			n = n.left
+		}
+	}
+	return rank
+}
+
+// Select returns the element at index k in ascending order, counting from 0.
+// The second value is false if k is out of range.
+func (o *OrderedSet[T]) Select(k int) (T, bool) {
+	var zero T
+	if k < 0 || k >= o.Size() {
+		return zero, false
+	}
+	n := o.root
+	for {
+		switch l := n.left.len(); {
+		case k < l:
+			n = n.left
+		case k == l:
+			return n.value, true
+		default:
+			k -= l + 1
+			n = n.right
+		}
+	}
+}
+
+// List returns the all elements as a slice in ascending order.
+func (o *OrderedSet[T]) List() []T {
+	var dest []T
+	if n := o.Size(); n > 0 {
+		dest = make([]T, 0, n)
+	}
+	o.Each(func(i T) {
+		dest = append(dest, i)
+	})
+	return dest
+}
+
+// SortedList returns the all elements as a slice sorted by less func.
+func (o *OrderedSet[T]) SortedList(less func(i, j T) bool) []T {
+	dest := o.List()
+	sort.Slice(dest, func(i, j int) bool {
+		return less(dest[i], dest[j])
+	})
+	return dest
+}
+
+// EachE traverses the elements in the OrderedSet in ascending order, calling
+// do func for each OrderedSet member. the cycle will be stopped when the do
+// func returns error. if err is ErrBreakEach, break the cycle and return nil,
+// else, break the cycle and return error.
+// The do func must not modify the OrderedSet.
+func (o *OrderedSet[T]) EachE(do func(i T) error) error {
+	var err error
+	o.root.each(func(i T) bool {
+		err = do(i)
+		return err == nil
+	})
+	if err == ErrBreakEach {
+		return nil
+	}
+	return err
+}
+
+// Each traverses the elements in the OrderedSet in ascending order, calling
+// do func for each OrderedSet member.
+// The do func must not modify the OrderedSet.
+func (o *OrderedSet[T]) Each(do func(i T)) {
+	o.root.each(func(i T) bool {
+		do(i)
+		return true
+	})
+}
+
+// Filter returns a new OrderedSet with the elements of OrderedSet o for which pred
+// returns true.
+func (o *OrderedSet[T]) Filter(pred func(i T) bool) *OrderedSet[T] {
+	in, _ := partitionElements(o.Each, pred)
+	return o.sorted(in)
+}
+
+// Partition returns two new OrderedSets, the first with the elements of OrderedSet o
+// for which pred returns true, the second with the others.
+func (o *OrderedSet[T]) Partition(pred func(i T) bool) (*OrderedSet[T], *OrderedSet[T]) {
+	in, out := partitionElements(o.Each, pred)
+	return o.sorted(in), o.sorted(out)
+}
+
+// Any reports whether pred returns true for any element of OrderedSet o, the
+// traversal stops at the first such element.
+func (o *OrderedSet[T]) Any(pred func(i T) bool) bool {
+	_, ok := findElement(o.EachE, pred)
+	return ok
+}
+
+// Every reports whether pred returns true for every element of OrderedSet o,
+// the traversal stops at the first element for which pred returns false.
+func (o *OrderedSet[T]) Every(pred func(i T) bool) bool {
+	_, ok := findElement(o.EachE, func(i T) bool {
+		return !pred(i)
+	})
+	return !ok
+}
+
+// Find returns the smallest element of OrderedSet o for which pred returns
+// true. The second value is false if there is no such element.
+func (o *OrderedSet[T]) Find(pred func(i T) bool) (T, bool) {
+	return findElement(o.EachE, pred)
+}
+
+// Count returns the number of elements of OrderedSet o for which pred returns
+// true.
+func (o *OrderedSet[T]) Count(pred func(i T) bool) int {
+	return countElements(o.Each, pred)
+}
+
+// combine returns a new OrderedSet which is op of OrderedSet o and s, by
+// merging their elements in order and building the tree in linear time.
+func (o *OrderedSet[T]) combine(s *OrderedSet[T], op int) *OrderedSet[T] {
+	x, y := o.List(), s.List()
+	var dest []T
+	i, j := 0, 0
+	for i < len(x) || j < len(y) {
+		switch {
+		case j == len(y) || (i < len(x) && lessOrdered(x[i], y[j])):
+			if op != opAnd {
+				dest = append(dest, x[i])
+			}
+			i++
+		case i == len(x) || lessOrdered(y[j], x[i]):
+			if op == opOr || op == opXor {
+				dest = append(dest, y[j])
+			}
+			j++
+		default:
+			if op == opOr || op == opAnd {
+				dest = append(dest, x[i])
+			}
+			i++
+			j++
+		}
+	}
+	return o.sorted(dest)
+}
+
+// replace replaces the elements of OrderedSet o with those of u, as a change
+// of o.
+func (o *OrderedSet[T]) replace(u *OrderedSet[T]) {
+	o.root, o.seed = u.root, u.seed
+	o.version++
+}
+
+// sorted returns a new OrderedSet with the sorted elements v.
+func (o *OrderedSet[T]) sorted(v []T) *OrderedSet[T] {
+	u := &OrderedSet[T]{seed: o.seed}
+	u.root = u.build(v)
+	return u
+}
+
+// Union returns the union of OrderedSet o and s.
+func (o *OrderedSet[T]) Union(s *OrderedSet[T]) *OrderedSet[T] {
+	return o.combine(s, opOr)
+}
+
+// Difference returns the difference of OrderedSet o and s.
+func (o *OrderedSet[T]) Difference(s *OrderedSet[T]) *OrderedSet[T] {
+	return o.combine(s, opAndNot)
+}
+
+// Intersection returns the intersection of OrderedSet o and s.
+func (o *OrderedSet[T]) Intersection(s *OrderedSet[T]) *OrderedSet[T] {
+	return o.combine(s, opAnd)
+}
+
+// SymmetricDifference returns a new OrderedSet with the elements that are either in this OrderedSet
+// or in the given OrderedSet, but not in both.
+func (o *OrderedSet[T]) SymmetricDifference(s *OrderedSet[T]) *OrderedSet[T] {
+	return o.combine(s, opXor)
+}
+
+// UnionWith adds all elements of OrderedSet s to OrderedSet o.
+func (o *OrderedSet[T]) UnionWith(s *OrderedSet[T]) {
+	o.replace(o.combine(s, opOr))
+}
+
+// DifferenceWith removes all elements of OrderedSet s from OrderedSet o.
+func (o *OrderedSet[T]) DifferenceWith(s *OrderedSet[T]) {
+	o.replace(o.combine(s, opAndNot))
+}
+
+// IntersectWith removes the elements of OrderedSet o which are not in OrderedSet s.
+func (o *OrderedSet[T]) IntersectWith(s *OrderedSet[T]) {
+	o.replace(o.combine(s, opAnd))
+}
+
+// SymmetricDifferenceWith keeps the elements that are either in OrderedSet o or in
+// OrderedSet s, but not in both.
+func (o *OrderedSet[T]) SymmetricDifferenceWith(s *OrderedSet[T]) {
+	o.replace(o.combine(s, opXor))
+}
+
+// IsSubset predicates that tests whether the OrderedSet o is a subset of OrderedSet s.
+func (o *OrderedSet[T]) IsSubset(s *OrderedSet[T]) bool {
+	if o.Size() > s.Size() {
+		return false
+	}
+	return o.root.each(s.Has)
+}
+
+// IsSuperset predicates that tests whether the OrderedSet o is a super of OrderedSet s.
+func (o *OrderedSet[T]) IsSuperset(s *OrderedSet[T]) bool {
+	return s.IsSubset(o)
+}
+
+// Equal predicates that tests whether the OrderedSet o equals of OrderedSet s.
+func (o *OrderedSet[T]) Equal(s *OrderedSet[T]) bool {
+	return o.Size() == s.Size() && o.IsSubset(s)
+}
+
+// Copy returns new OrderedSet that clones from OrderedSet.
+func (o *OrderedSet[T]) Copy() *OrderedSet[T] {
+	return &OrderedSet[T]{root: o.root.clone(), seed: o.seed}
+}
+
+// String returns a string representation of OrderedSet in ascending order.
+func (o *OrderedSet[T]) String() string {
+	return joinElements(o.List(), "%v")
+}
+
+// Format implements fmt.Formatter, the elements are in ascending order.
+// See Set.Format for the verbs.
+func (o *OrderedSet[T]) Format(f fmt.State, verb rune) {
+	formatElements(f, verb, o.List(), "NewOrderedSet["+typeName[T]()+"]")
+}
+
+// MarshalJSON implements json.Marshaler, it encodes the OrderedSet as a JSON
+// array in ascending order.
+func (o *OrderedSet[T]) MarshalJSON() ([]byte, error) {
+	v := o.List()
+	if v == nil {
+		v = []T{}
+	}
+	return marshalElements(v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, it replaces the OrderedSet with
+// the elements of a JSON array, merging duplicate elements.
+func (o *OrderedSet[T]) UnmarshalJSON(data []byte) error {
+	var v []T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	o.Clear()
+	o.Add(v...)
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler, it encodes the OrderedSet as a
+// sequence in ascending order.
+func (o *OrderedSet[T]) MarshalYAML() (interface{}, error) {
+	return yamlElements(o.List()), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, it replaces the OrderedSet with
+// the elements of a sequence, merging duplicate elements.
+func (o *OrderedSet[T]) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var v []T
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	o.Clear()
+	o.Add(v...)
+	return nil
+}
@@ -0,0 +1,314 @@
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package set
+
+import (
+	"encoding/json"
+	"math"
+	"math/rand"
+	"sort"
+	"strconv"
+	"testing"
+)
+
+func TestOrderedSet(t *testing.T) {
+	t.Run("Int", func(t *testing.T) {
+		testOrderedSet(t, func(r *rand.Rand) int { return r.Intn(200) - 100 })
+	})
+	t.Run("Uint8", func(t *testing.T) {
+		testOrderedSet(t, func(r *rand.Rand) uint8 { return uint8(r.Intn(256)) })
+	})
+	t.Run("Float64", func(t *testing.T) {
+		testOrderedSet(t, func(r *rand.Rand) float64 {
+			if r.Intn(20) == 0 {
+				return math.NaN()
+			}
+			return float64(r.Intn(200)-100) / 4
+		})
+	})
+	t.Run("String", func(t *testing.T) {
+		testOrderedSet(t, func(r *rand.Rand) string { return strconv.Itoa(r.Intn(200)) })
+	})
+}
+
+// validateTree checks that the treap is a binary search tree on the values,
+// a heap on the priorities and that the sizes are up to date.
+func validateTree[T Ordered](t *testing.T, n *node[T]) {
+	if n == nil {
+		return
+	}
+	if n.size != 1+n.left.len()+n.right.len() {
+		t.Fatalf("expect size: %d, but got: %d", 1+n.left.len()+n.right.len(), n.size)
+	}
+	if n.left != nil && (n.left.priority > n.priority || !lessOrdered(n.left.value, n.value)) {
+		t.Fatalf("unexpected left child %v of %v", n.left.value, n.value)
+	}
+	if n.right != nil && (n.right.priority > n.priority || !lessOrdered(n.value, n.right.value)) {
+		t.Fatalf("unexpected right child %v of %v", n.right.value, n.value)
+	}
+	validateTree(t, n.left)
+	validateTree(t, n.right)
+}
+
+// testOrderedSet checks every operation of OrderedSet against a sorted slice
+// and the same operation of Set on random elements.
+func testOrderedSet[T Ordered](t *testing.T, random func(r *rand.Rand) T) {
+	r := rand.New(rand.NewSource(1))
+	randomSet := func() (*OrderedSet[T], []T) {
+		o := NewOrderedSet[T]()
+		for i := r.Intn(100); i > 0; i-- {
+			o.Add(random(r))
+		}
+		for i := r.Intn(20); i > 0; i-- {
+			o.Remove(random(r))
+		}
+		validateTree(t, o.root)
+		var expect []T
+		for _, element := range o.List() {
+			if n := len(expect); n == 0 || lessOrdered(expect[n-1], element) {
+				expect = append(expect, element)
+			}
+		}
+		return o, expect
+	}
+	equal := func(a, b []T) bool {
+		if len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if compareOrdered(a[i], b[i]) != 0 {
+				return false
+			}
+		}
+		return true
+	}
+	for n := 0; n < 100; n++ {
+		o, sorted := randomSet()
+		c, _ := randomSet()
+		if list := o.List(); !equal(list, sorted) || o.Size() != len(sorted) {
+			t.Fatalf("expect ascending list: %v, but got: %v", sorted, list)
+		}
+		if min, ok := o.Min(); ok != (len(sorted) > 0) || (ok && compareOrdered(min, sorted[0]) != 0) {
+			t.Errorf("unexpected Min: %v of: %v", min, sorted)
+		}
+		if max, ok := o.Max(); ok != (len(sorted) > 0) || (ok && compareOrdered(max, sorted[len(sorted)-1]) != 0) {
+			t.Errorf("unexpected Max: %v of: %v", max, sorted)
+		}
+		for i := 0; i < 10; i++ {
+			x := random(r)
+			rank := sort.Search(len(sorted), func(i int) bool { return !lessOrdered(sorted[i], x) })
+			if actual := o.Rank(x); actual != rank {
+				t.Errorf("expect rank of %v: %d, but got: %d", x, rank, actual)
+			}
+			if k, ok := o.Select(rank); ok != (rank < len(sorted)) || (ok && compareOrdered(k, sorted[rank]) != 0) {
+				t.Errorf("unexpected Select(%d): %v", rank, k)
+			}
+			ceiling, ok := o.Ceiling(x)
+			if ok != (rank < len(sorted)) || (ok && compareOrdered(ceiling, sorted[rank]) != 0) {
+				t.Errorf("unexpected Ceiling(%v): %v", x, ceiling)
+			}
+			floor, ok := o.Floor(x)
+			f := rank - 1
+			if o.Has(x) {
+				f = rank
+			}
+			if ok != (f >= 0) || (ok && compareOrdered(floor, sorted[f]) != 0) {
+				t.Errorf("unexpected Floor(%v): %v", x, floor)
+			}
+			y := random(r)
+			if lessOrdered(y, x) {
+				x, y = y, x
+			}
+			lo := sort.Search(len(sorted), func(i int) bool { return !lessOrdered(sorted[i], x) })
+			hi := sort.Search(len(sorted), func(i int) bool { return !lessOrdered(sorted[i], y) })
+			if actual := o.Range(x, y); !equal(actual, sorted[lo:hi]) {
+				t.Errorf("expect Range(%v, %v): %v, but got: %v", x, y, sorted[lo:hi], actual)
+			}
+		}
+
+		// the algebra is checked on the membership of every element of o
+		// and c rather than against Set, which cannot hold NaN
+		elements := append(o.List(), c.List()...)
+		validate := func(name string, actual *OrderedSet[T], op func(a, b bool) bool) {
+			validateTree(t, actual.root)
+			size := 0
+			for _, element := range NewOrderedSet(elements...).List() {
+				expect := op(o.Has(element), c.Has(element))
+				if expect {
+					size++
+				}
+				if actual.Has(element) != expect {
+					t.Errorf("expect %s to have %v: %v, but got: %s", name, element, expect, actual)
+				}
+			}
+			if actual.Size() != size {
+				t.Errorf("expect %s size: %d, but got: %d", name, size, actual.Size())
+			}
+		}
+		union := func(a, b bool) bool { return a || b }
+		difference := func(a, b bool) bool { return a && !b }
+		intersection := func(a, b bool) bool { return a && b }
+		symmetricDifference := func(a, b bool) bool { return a != b }
+		validate("Union", o.Union(c), union)
+		validate("Difference", o.Difference(c), difference)
+		validate("Intersection", o.Intersection(c), intersection)
+		validate("SymmetricDifference", o.SymmetricDifference(c), symmetricDifference)
+		w := o.Copy()
+		w.UnionWith(c)
+		validate("UnionWith", w, union)
+		w = o.Copy()
+		w.DifferenceWith(c)
+		validate("DifferenceWith", w, difference)
+		w = o.Copy()
+		w.IntersectWith(c)
+		validate("IntersectWith", w, intersection)
+		w = o.Copy()
+		w.SymmetricDifferenceWith(c)
+		validate("SymmetricDifferenceWith", w, symmetricDifference)
+
+		subset := o.Difference(c).IsEmpty()
+		if o.IsSubset(c) != subset || c.IsSuperset(o) != subset || o.Equal(c) != (subset && c.Difference(o).IsEmpty()) {
+			t.Errorf("unexpected predicates on: %s and %s", o, c)
+		}
+		if !o.Intersection(c).IsSubset(o) || !o.Equal(o.Copy()) || !o.Union(c).IsSuperset(c) {
+			t.Errorf("unexpected predicates on: %s", o)
+		}
+		for k, ok := o.Pop(); ok; k, ok = o.Pop() {
+			if compareOrdered(k, sorted[0]) != 0 {
+				t.Fatalf("expect to pop the smallest element %v, but got: %v", sorted[0], k)
+			}
+			sorted = sorted[1:]
+			validateTree(t, o.root)
+		}
+		if len(sorted) != 0 || !o.IsEmpty() {
+			t.Errorf("expect empty set, but got: %s", o)
+		}
+	}
+}
+
+func TestOrderedSet_NaN(t *testing.T) {
+	nan := math.NaN()
+	o := NewOrderedSet(2, nan, math.Inf(-1), nan, 1)
+	if o.Size() != 4 || !o.Has(nan) || o.String() != "[NaN, -Inf, 1, 2]" {
+		t.Errorf("expect [NaN, -Inf, 1, 2], but got: %s", o)
+	}
+	if k, ok := o.Min(); !ok || k == k {
+		t.Errorf("expect Min to be NaN, but got: %v", k)
+	}
+	if k, ok := o.Floor(0); !ok || k != math.Inf(-1) {
+		t.Errorf("expect Floor(0) to be -Inf, but got: %v", k)
+	}
+	if rank := o.Rank(math.Inf(-1)); rank != 1 {
+		t.Errorf("expect rank of -Inf: 1, but got: %d", rank)
+	}
+	o.Remove(nan)
+	if o.Has(nan) || o.Size() != 3 {
+		t.Errorf("expect NaN to be removed, but got: %s", o)
+	}
+	if o.Add(math.Copysign(0, -1)); !o.Has(0) {
+		t.Errorf("expect -0 to equal 0")
+	}
+
+	u := NewOrderedSetFromSet(NewFloat64(nan, nan, 1))
+	if u.Size() != 2 || u.String() != "[NaN, 1]" {
+		t.Errorf("expect NaNs of the Set merged: [NaN, 1], but got: %s (size: %d)", u, u.Size())
+	}
+	if u.Remove(nan); u.Has(nan) || u.Size() != 1 {
+		t.Errorf("expect NaN to be removed, but got: %s (size: %d)", u, u.Size())
+	}
+}
+
+func TestOrderedSet_Zero(t *testing.T) {
+	var o, c OrderedSet[string]
+	if !o.IsEmpty() || o.Has("") || o.Size() != 0 || !o.Equal(&c) || o.Union(&c).Size() != 0 {
+		t.Errorf("expect zero OrderedSet to be empty")
+	}
+	if _, ok := o.Pop(); ok {
+		t.Errorf("expect Pop on zero OrderedSet to fail")
+	}
+	if _, ok := o.Select(0); ok {
+		t.Errorf("expect Select on zero OrderedSet to fail")
+	}
+	if v := o.Range("a", "z"); len(v) != 0 {
+		t.Errorf("expect empty range, but got: %v", v)
+	}
+	o.Add("b", "a")
+	if o.String() != "[a, b]" {
+		t.Errorf("expect [a, b], but got: %s", o.String())
+	}
+	if u := NewOrderedSetFromSet(NewString("b", "a")); !u.Equal(&o) {
+		t.Errorf("expect [a, b], but got: %s", u)
+	}
+	validateSet(t, o.ToSet(), []string{"a", "b"})
+	var visited []string
+	NewOrderedSet("c", "a", "b").EachE(func(i string) error {
+		visited = append(visited, i)
+		if len(visited) == 2 {
+			return ErrBreakEach
+		}
+		return nil
+	})
+	if len(visited) != 2 || visited[0] != "a" || visited[1] != "b" {
+		t.Errorf("expect visited: [a b], but got: %v", visited)
+	}
+}
+
+func TestOrderedSet_JSON(t *testing.T) {
+	o := NewOrderedSet[uint8](3, 255, 1)
+	data, err := json.Marshal(o)
+	if err != nil || string(data) != "[1,3,255]" {
+		t.Fatalf("expect json: [1,3,255], but got: %s, %v", data, err)
+	}
+	c := NewOrderedSet[uint8](9)
+	if err := json.Unmarshal(data, c); err != nil || !c.Equal(o) {
+		t.Errorf("expect %s, but got: %s, %v", o, c, err)
+	}
+}
+
+func BenchmarkOrderedSet_List(b *testing.B) {
+	s, _ := benchmarkRoaringSets(10000)
+	o := NewOrderedSetFromSet(s)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		o.List()
+	}
+}
+
+func BenchmarkSet_SortedList(b *testing.B) {
+	s, _ := benchmarkRoaringSets(10000)
+	less := func(i, j uint32) bool { return i < j }
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.SortedList(less)
+	}
+}
+
+func BenchmarkOrderedSet_Add(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	o := NewOrderedSet[int]()
+	for i := 0; i < b.N; i++ {
+		o.Add(r.Int())
+	}
+}
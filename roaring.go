@@ -0,0 +1,654 @@
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package set
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// ErrInvalidEncoding is returned when decoding a set from a binary encoding
+// which is truncated, corrupted or of an unknown version.
+var ErrInvalidEncoding = errors.New("invalid encoding")
+
+// Wide is a constraint that permits the 32 and 64 bit integer types stored
+// by a Roaring.
+type Wide interface {
+	~int32 | ~uint32 | ~uint64
+}
+
+// Roaring is a collection of T that contains no duplicate elements, stored as
+// a compressed roaring bitmap: the elements are grouped by their high bits,
+// and the low 16 bits of each group are kept in a container which is a
+// sorted array, a bitmap or a list of runs, whichever fits the group.
+// It has the same methods as Set, the size is kept per container so Size is
+// cheap, and the elements are traversed in ascending order.
+// The zero value is an empty Roaring ready to use.
+//
+// Reference: https://roaringbitmap.org
+type Roaring[T Wide] struct {
+	// keys are the high bits of the groups in ascending order, containers
+	// holds the container of each key.
+	keys       []uint64
+	containers []container
+}
+
+// NewRoaring initializes a new Roaring.
+func NewRoaring[T Wide](elements ...T) *Roaring[T] {
+	r := &Roaring[T]{}
+	r.Add(elements...)
+	return r
+}
+
+// NewRoaringFromSet initializes a new Roaring with the elements of Set s.
+func NewRoaringFromSet[T Wide](s Set[T]) *Roaring[T] {
+	v := s.List()
+	sortOrdered(v)
+	return NewRoaring(v...)
+}
+
+// ToSet returns a Set with the elements of Roaring.
+func (r *Roaring[T]) ToSet() Set[T] {
+	s := NewWithSize[T](r.Size())
+	r.Each(func(i T) {
+		s[i] = struct{}{}
+	})
+	return s
+}
+
+// roaringKey maps the element to an unsigned value, the domain of int32 is
+// shifted so that the values are in the order of the elements.
+func roaringKey[T Wide](element T) uint64 {
+	var zero T
+	if ^zero < 0 {
+		return uint64(uint32(element) ^ 1<<31)
+	}
+	return uint64(element)
+}
+
+// roaringValue is the inverse of roaringKey.
+func roaringValue[T Wide](x uint64) T {
+	var zero T
+	if ^zero < 0 {
+		return T(int32(uint32(x) ^ 1<<31))
+	}
+	return T(x)
+}
+
+// search returns the index of the container of the key, and whether it exists.
+func (r *Roaring[T]) search(key uint64) (int, bool) {
+	i := sort.Search(len(r.keys), func(i int) bool {
+		return r.keys[i] >= key
+	})
+	return i, i < len(r.keys) && r.keys[i] == key
+}
+
+// Add adds the elements to Roaring, if it is not present already.
+func (r *Roaring[T]) Add(elements ...T) {
+	for _, element := range elements {
+		x := roaringKey(element)
+		i, ok := r.search(x >> 16)
+		if ok {
+			r.containers[i] = r.containers[i].add(uint16(x))
+			continue
+		}
+		r.keys = append(r.keys, 0)
+		copy(r.keys[i+1:], r.keys[i:])
+		r.keys[i] = x >> 16
+		r.containers = append(r.containers, nil)
+		copy(r.containers[i+1:], r.containers[i:])
+		r.containers[i] = &arrayContainer{values: []uint16{uint16(x)}}
+	}
+}
+
+// Remove removes the element from Roaring, if it is present.
+func (r *Roaring[T]) Remove(elements ...T) {
+	for _, element := range elements {
+		x := roaringKey(element)
+		i, ok := r.search(x >> 16)
+		if !ok {
+			continue
+		}
+		r.containers[i] = r.containers[i].remove(uint16(x))
+		if r.containers[i].card() == 0 {
+			r.keys = append(r.keys[:i], r.keys[i+1:]...)
+			r.containers = append(r.containers[:i], r.containers[i+1:]...)
+		}
+	}
+}
+
+// ceiling returns the smallest element of Roaring whose key is not less than
+// x, the second value is false if there is none.
+func (r *Roaring[T]) ceiling(x uint64) (T, bool) {
+	i, ok := r.search(x >> 16)
+	if ok {
+		if low, ok := r.containers[i].next(uint16(x)); ok {
+			return roaringValue[T](r.keys[i]<<16 | uint64(low)), true
+		}
+		i++
+	}
+	if i == len(r.keys) {
+		var zero T
+		return zero, false
+	}
+	// the containers are not empty
+	low, _ := r.containers[i].next(0)
+	return roaringValue[T](r.keys[i]<<16 | uint64(low)), true
+}
+
+// higher returns the smallest element of Roaring which is greater than the
+// element, the second value is false if there is none.
+func (r *Roaring[T]) higher(element T) (T, bool) {
+	x := roaringKey(element)
+	if x == 1<<64-1 {
+		var zero T
+		return zero, false
+	}
+	return r.ceiling(x + 1)
+}
+
+// Pop returns the smallest element of Roaring, deleting it from Roaring.
+// The second value is a bool that is true if the elements existed in
+// the Roaring, and false if not.
+func (r *Roaring[T]) Pop() (T, bool) {
+	var zero T
+	if len(r.keys) == 0 {
+		return zero, false
+	}
+	var low uint16
+	r.containers[0].each(func(x uint16) bool {
+		low = x
+		return false
+	})
+	element := roaringValue[T](r.keys[0]<<16 | uint64(low))
+	r.Remove(element)
+	return element, true
+}
+
+// Size returns the number of elements in Roaring.
+func (r *Roaring[T]) Size() int {
+	n := 0
+	for _, c := range r.containers {
+		n += c.card()
+	}
+	return n
+}
+
+// IsEmpty returns whether the Roaring is Empty.
+func (r *Roaring[T]) IsEmpty() bool {
+	return len(r.keys) == 0
+}
+
+// Clear removes all items from the Roaring.
+func (r *Roaring[T]) Clear() {
+	r.keys, r.containers = nil, nil
+}
+
+// Has judges the specified element whether exists in the Roaring.
+// it returns true if existed, and false if not.
+func (r *Roaring[T]) Has(element T) bool {
+	x := roaringKey(element)
+	i, ok := r.search(x >> 16)
+	return ok && r.containers[i].has(uint16(x))
+}
+
+// HasAll looks for the specified elements to judge
+// whether all exist in the Roaring.
+// it returns true if existed, and false if not.
+func (r *Roaring[T]) HasAll(elements ...T) bool {
+	for _, element := range elements {
+		if !r.Has(element) {
+			return false
+		}
+	}
+	return true
+}
+
+// HasAny looks for the specified elements to judge
+// whether at least one of the element exists in the Roaring.
+// it returns true if existed, and false if not.
+func (r *Roaring[T]) HasAny(elements ...T) bool {
+	for _, element := range elements {
+		if r.Has(element) {
+			return true
+		}
+	}
+	return false
+}
+
+// List returns the all elements as a slice in ascending order.
+func (r *Roaring[T]) List() []T {
+	var dest []T
+	if n := r.Size(); n > 0 {
+		dest = make([]T, 0, n)
+	}
+	r.Each(func(i T) {
+		dest = append(dest, i)
+	})
+	return dest
+}
+
+// SortedList returns the all elements as a slice sorted by less func.
+func (r *Roaring[T]) SortedList(less func(i, j T) bool) []T {
+	dest := r.List()
+	sort.Slice(dest, func(i, j int) bool {
+		return less(dest[i], dest[j])
+	})
+	return dest
+}
+
+// EachE traverses the elements in the Roaring in ascending order, calling do
+// func for each Roaring member. the cycle will be stopped when the do func
+// returns error. if err is ErrBreakEach, break the cycle and return nil,
+// else, break the cycle and return error.
+func (r *Roaring[T]) EachE(do func(i T) error) error {
+	var err error
+	for i, c := range r.containers {
+		high := r.keys[i] << 16
+		if !c.each(func(x uint16) bool {
+			err = do(roaringValue[T](high | uint64(x)))
+			return err == nil
+		}) {
+			break
+		}
+	}
+	if err == ErrBreakEach {
+		return nil
+	}
+	return err
+}
+
+// Each traverses the elements in the Roaring in ascending order, calling do
+// func for each Roaring member.
+func (r *Roaring[T]) Each(do func(i T)) {
+	for i, c := range r.containers {
+		high := r.keys[i] << 16
+		c.each(func(x uint16) bool {
+			do(roaringValue[T](high | uint64(x)))
+			return true
+		})
+	}
+}
+
+// Filter returns a new Roaring with the elements of Roaring r for which pred
+// returns true.
+func (r *Roaring[T]) Filter(pred func(i T) bool) *Roaring[T] {
+	in, _ := partitionElements(r.Each, pred)
+	return NewRoaring(in...)
+}
+
+// Partition returns two new Roarings, the first with the elements of Roaring r
+// for which pred returns true, the second with the others.
+func (r *Roaring[T]) Partition(pred func(i T) bool) (*Roaring[T], *Roaring[T]) {
+	in, out := partitionElements(r.Each, pred)
+	return NewRoaring(in...), NewRoaring(out...)
+}
+
+// Any reports whether pred returns true for any element of Roaring r, the
+// traversal stops at the first such element.
+func (r *Roaring[T]) Any(pred func(i T) bool) bool {
+	_, ok := findElement(r.EachE, pred)
+	return ok
+}
+
+// Every reports whether pred returns true for every element of Roaring r,
+// the traversal stops at the first element for which pred returns false.
+func (r *Roaring[T]) Every(pred func(i T) bool) bool {
+	_, ok := findElement(r.EachE, func(i T) bool {
+		return !pred(i)
+	})
+	return !ok
+}
+
+// Find returns the smallest element of Roaring r for which pred returns true.
+// The second value is false if there is no such element.
+func (r *Roaring[T]) Find(pred func(i T) bool) (T, bool) {
+	return findElement(r.EachE, pred)
+}
+
+// Count returns the number of elements of Roaring r for which pred returns
+// true.
+func (r *Roaring[T]) Count(pred func(i T) bool) int {
+	return countElements(r.Each, pred)
+}
+
+// combine returns a new Roaring which is op of Roaring r and s, merging the
+// containers of the same key.
+func (r *Roaring[T]) combine(s *Roaring[T], op int) *Roaring[T] {
+	u := &Roaring[T]{}
+	push := func(key uint64, c container) {
+		if c != nil {
+			u.keys = append(u.keys, key)
+			u.containers = append(u.containers, c)
+		}
+	}
+	i, j := 0, 0
+	for i < len(r.keys) || j < len(s.keys) {
+		switch {
+		case j == len(s.keys) || (i < len(r.keys) && r.keys[i] < s.keys[j]):
+			if op != opAnd {
+				push(r.keys[i], r.containers[i].clone())
+			}
+			i++
+		case i == len(r.keys) || s.keys[j] < r.keys[i]:
+			if op == opOr || op == opXor {
+				push(s.keys[j], s.containers[j].clone())
+			}
+			j++
+		default:
+			push(r.keys[i], combineContainers(r.containers[i], s.containers[j], op))
+			i++
+			j++
+		}
+	}
+	return u
+}
+
+// Union returns the union of Roaring r and s.
+func (r *Roaring[T]) Union(s *Roaring[T]) *Roaring[T] {
+	return r.combine(s, opOr)
+}
+
+// Difference returns the difference of Roaring r and s.
+func (r *Roaring[T]) Difference(s *Roaring[T]) *Roaring[T] {
+	return r.combine(s, opAndNot)
+}
+
+// Intersection returns the intersection of Roaring r and s.
+func (r *Roaring[T]) Intersection(s *Roaring[T]) *Roaring[T] {
+	return r.combine(s, opAnd)
+}
+
+// SymmetricDifference returns a new Roaring with the elements that are either in this Roaring
+// or in the given Roaring, but not in both.
+func (r *Roaring[T]) SymmetricDifference(s *Roaring[T]) *Roaring[T] {
+	return r.combine(s, opXor)
+}
+
+// UnionWith adds all elements of Roaring s to Roaring r.
+func (r *Roaring[T]) UnionWith(s *Roaring[T]) {
+	*r = *r.combine(s, opOr)
+}
+
+// DifferenceWith removes all elements of Roaring s from Roaring r.
+func (r *Roaring[T]) DifferenceWith(s *Roaring[T]) {
+	*r = *r.combine(s, opAndNot)
+}
+
+// IntersectWith removes the elements of Roaring r which are not in Roaring s.
+func (r *Roaring[T]) IntersectWith(s *Roaring[T]) {
+	*r = *r.combine(s, opAnd)
+}
+
+// SymmetricDifferenceWith keeps the elements that are either in Roaring r or in
+// Roaring s, but not in both.
+func (r *Roaring[T]) SymmetricDifferenceWith(s *Roaring[T]) {
+	*r = *r.combine(s, opXor)
+}
+
+// IsSubset predicates that tests whether the Roaring r is a subset of Roaring s.
+func (r *Roaring[T]) IsSubset(s *Roaring[T]) bool {
+	for i, key := range r.keys {
+		j, ok := s.search(key)
+		if !ok || combineContainers(r.containers[i], s.containers[j], opAndNot) != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSuperset predicates that tests whether the Roaring r is a super of Roaring s.
+func (r *Roaring[T]) IsSuperset(s *Roaring[T]) bool {
+	return s.IsSubset(r)
+}
+
+// Equal predicates that tests whether the Roaring r equals of Roaring s.
+func (r *Roaring[T]) Equal(s *Roaring[T]) bool {
+	return len(r.keys) == len(s.keys) && r.Size() == s.Size() && r.IsSubset(s)
+}
+
+// Copy returns new Roaring that clones from Roaring.
+func (r *Roaring[T]) Copy() *Roaring[T] {
+	u := &Roaring[T]{
+		keys:       make([]uint64, len(r.keys)),
+		containers: make([]container, len(r.containers)),
+	}
+	copy(u.keys, r.keys)
+	for i, c := range r.containers {
+		u.containers[i] = c.clone()
+	}
+	return u
+}
+
+// RunOptimize converts every container to its smallest representation,
+// which is a list of runs for groups of consecutive elements. It is meant
+// to be called once the Roaring has been loaded, a run container is
+// expanded again by the next Add or Remove on it.
+func (r *Roaring[T]) RunOptimize() {
+	for i, c := range r.containers {
+		r.containers[i] = optimize(c)
+	}
+}
+
+// String returns a string representation of Roaring
+func (r *Roaring[T]) String() string {
+	return joinElements(r.List(), "%v")
+}
+
+// Format implements fmt.Formatter, the elements are in ascending order.
+// See Set.Format for the verbs.
+func (r *Roaring[T]) Format(f fmt.State, verb rune) {
+	formatElements(f, verb, r.List(), "NewRoaring["+typeName[T]()+"]")
+}
+
+// MarshalJSON implements json.Marshaler, it encodes the Roaring as a JSON
+// array in ascending order.
+func (r *Roaring[T]) MarshalJSON() ([]byte, error) {
+	v := r.List()
+	if v == nil {
+		v = []T{}
+	}
+	return json.Marshal(v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, it replaces the Roaring with the
+// elements of a JSON array, merging duplicate elements.
+func (r *Roaring[T]) UnmarshalJSON(data []byte) error {
+	var v []T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	sortOrdered(v)
+	r.Clear()
+	r.Add(v...)
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler, it encodes the Roaring as a
+// sequence in ascending order.
+func (r *Roaring[T]) MarshalYAML() (interface{}, error) {
+	return yamlElements(r.List()), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, it replaces the Roaring with the
+// elements of a sequence, merging duplicate elements.
+func (r *Roaring[T]) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var v []T
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	sortOrdered(v)
+	r.Clear()
+	r.Add(v...)
+	return nil
+}
+
+// roaringVersion is the version of the binary encoding of Roaring.
+const roaringVersion = 1
+
+// the kinds of the containers in the binary encoding.
+const (
+	arrayKind = iota
+	bitmapKind
+	runKind
+)
+
+// MarshalBinary implements encoding.BinaryMarshaler. The encoding is a
+// version byte, followed by the number of containers as an uvarint and then
+// by each container: the difference of its key to the previous key as an
+// uvarint, its kind byte, the number of values or runs as an uvarint and
+// its payload, which is little endian uint16 values, little endian uint64
+// words or little endian uint16 start and length of the runs.
+func (r *Roaring[T]) MarshalBinary() ([]byte, error) {
+	size := 1 + binary.MaxVarintLen64
+	for _, c := range r.containers {
+		size += 2*binary.MaxVarintLen64 + 1 + c.size()
+	}
+	data := make([]byte, 0, size)
+	data = append(data, roaringVersion)
+	data = binary.AppendUvarint(data, uint64(len(r.keys)))
+	var prev uint64
+	for i, c := range r.containers {
+		data = binary.AppendUvarint(data, r.keys[i]-prev)
+		prev = r.keys[i]
+		switch c := c.(type) {
+		case *arrayContainer:
+			data = append(data, arrayKind)
+			data = binary.AppendUvarint(data, uint64(len(c.values)))
+			for _, x := range c.values {
+				data = binary.LittleEndian.AppendUint16(data, x)
+			}
+		case *bitmapContainer:
+			data = append(data, bitmapKind)
+			data = binary.AppendUvarint(data, uint64(c.n))
+			for _, word := range c.bits {
+				data = binary.LittleEndian.AppendUint64(data, word)
+			}
+		case *runContainer:
+			data = append(data, runKind)
+			data = binary.AppendUvarint(data, uint64(len(c.runs)))
+			for _, run := range c.runs {
+				data = binary.LittleEndian.AppendUint16(data, run.start)
+				data = binary.LittleEndian.AppendUint16(data, run.last-run.start)
+			}
+		}
+	}
+	return data, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, it replaces the
+// Roaring with the decoded elements. It returns an error wrapping
+// ErrInvalidEncoding if data is not a valid encoding of MarshalBinary.
+func (r *Roaring[T]) UnmarshalBinary(data []byte) error {
+	invalid := func(reason string) error {
+		return fmt.Errorf("set: roaring %s: %w", reason, ErrInvalidEncoding)
+	}
+	d := decoder{data: data}
+	if version, ok := d.byte(); !ok || version != roaringVersion {
+		return invalid("unknown version")
+	}
+	n, ok := d.uvarint()
+	if !ok || n > uint64(len(data)) {
+		return invalid("truncated container count")
+	}
+	var maxKey uint64 = 1<<48 - 1
+	if roaringKey(^T(0)) < 1<<32 {
+		maxKey = 1<<16 - 1
+	}
+	u := Roaring[T]{keys: make([]uint64, 0, n), containers: make([]container, 0, n)}
+	var key uint64
+	for i := uint64(0); i < n; i++ {
+		delta, ok := d.uvarint()
+		if !ok || (i > 0 && delta == 0) || key+delta < key {
+			return invalid("invalid key")
+		}
+		key += delta
+		if key > maxKey {
+			return invalid("key out of range")
+		}
+		kind, ok := d.byte()
+		if !ok {
+			return invalid("truncated container")
+		}
+		count, ok := d.uvarint()
+		if !ok || count == 0 {
+			return invalid("invalid container size")
+		}
+		var c container
+		switch kind {
+		case arrayKind:
+			if count > arrayMax || !d.has(2*count) {
+				return invalid("invalid array container")
+			}
+			a := &arrayContainer{values: make([]uint16, count)}
+			for j := range a.values {
+				a.values[j], _ = d.uint16()
+				if j > 0 && a.values[j] <= a.values[j-1] {
+					return invalid("unsorted array container")
+				}
+			}
+			c = a
+		case bitmapKind:
+			if count <= arrayMax || count > 1<<16 || !d.has(8*bitmapWords) {
+				return invalid("invalid bitmap container")
+			}
+			var w [bitmapWords]uint64
+			for j := range w {
+				w[j], _ = d.uint64()
+			}
+			b := newBitmapContainer(&w)
+			if uint64(b.n) != count {
+				return invalid("bitmap container size mismatch")
+			}
+			c = b
+		case runKind:
+			if count > 1<<15 || !d.has(4*count) {
+				return invalid("invalid run container")
+			}
+			rc := &runContainer{runs: make([]interval, count)}
+			for j := range rc.runs {
+				start, _ := d.uint16()
+				length, _ := d.uint16()
+				if int(start)+int(length) > 1<<16-1 || (j > 0 && int(start) <= int(rc.runs[j-1].last)+1) {
+					return invalid("invalid run")
+				}
+				rc.runs[j] = interval{start: start, last: start + length}
+			}
+			c = rc
+		default:
+			return invalid("unknown container kind")
+		}
+		u.keys = append(u.keys, key)
+		u.containers = append(u.containers, c)
+	}
+	if len(d.data) != 0 {
+		return invalid("trailing data")
+	}
+	*r = u
+	return nil
+}
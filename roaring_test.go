@@ -0,0 +1,348 @@
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package set
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestRoaring(t *testing.T) {
+	t.Run("Int32", func(t *testing.T) { testRoaring[int32](t, math.MinInt32, math.MaxInt32) })
+	t.Run("Uint32", func(t *testing.T) { testRoaring[uint32](t, 0, math.MaxUint32) })
+	t.Run("Uint64", func(t *testing.T) { testRoaring[uint64](t, 0, math.MaxUint64) })
+}
+
+// randomRoaringSet returns a Set with the bounds of the domain and a few
+// groups of elements, each of which is sparse, dense or made of runs, so
+// that every kind of container is built.
+func randomRoaringSet[T Wide](r *rand.Rand, min, max T) Set[T] {
+	s := New(min, max)
+	bases := []T{min, max &^ 0xffff, T(r.Uint64()) &^ 0xffff, T(r.Uint64()) &^ 0xffff}
+	for _, base := range bases[:r.Intn(len(bases)+1)] {
+		switch r.Intn(3) {
+		case 0:
+			for i := r.Intn(100); i > 0; i-- {
+				s.Add(base | T(r.Intn(1<<16)))
+			}
+		case 1:
+			for i := 4000 + r.Intn(8000); i > 0; i-- {
+				s.Add(base | T(r.Intn(1<<16)))
+			}
+		case 2:
+			for i := r.Intn(8); i > 0; i-- {
+				start := r.Intn(1 << 16)
+				for x := start; x < start+r.Intn(5000) && x < 1<<16; x++ {
+					s.Add(base | T(x))
+				}
+			}
+		}
+	}
+	return s
+}
+
+// testRoaring checks every operation of Roaring against the same operation of
+// Set on random elements, including the bounds of the domain.
+func testRoaring[T Wide](t *testing.T, min, max T) {
+	r := rand.New(rand.NewSource(1))
+	less := func(i, j T) bool { return i < j }
+	for n := 0; n < 16; n++ {
+		s, u := randomRoaringSet(r, min, max), randomRoaringSet(r, min, max)
+		b, c := NewRoaringFromSet(s), NewRoaringFromSet(u)
+		if n%2 == 0 {
+			b.RunOptimize()
+		}
+		validateSet(t, b.ToSet(), s.List())
+		if b.Size() != s.Size() || b.IsEmpty() {
+			t.Errorf("expect size: %d, but got: %d", s.Size(), b.Size())
+		}
+		list := b.List()
+		sorted := s.SortedList(less)
+		for i := range sorted {
+			if list[i] != sorted[i] {
+				t.Fatalf("expect ascending list at %d: %v, but got: %v", i, sorted[i], list[i])
+			}
+		}
+		validateSet(t, b.Union(c).ToSet(), s.Union(u).List())
+		validateSet(t, b.Difference(c).ToSet(), s.Difference(u).List())
+		validateSet(t, b.Intersection(c).ToSet(), s.Intersection(u).List())
+		validateSet(t, b.SymmetricDifference(c).ToSet(), s.SymmetricDifference(u).List())
+		if b.IsSubset(c) != s.IsSubset(u) || b.IsSuperset(c) != s.IsSuperset(u) || b.Equal(c) != s.Equal(u) {
+			t.Errorf("unexpected predicates on the scenario: %d", n)
+		}
+		if !b.Intersection(c).IsSubset(b) || !b.Equal(b.Copy()) {
+			t.Errorf("unexpected predicates on the scenario: %d", n)
+		}
+
+		w, v := b.Copy(), s.Copy()
+		w.UnionWith(c)
+		v.UnionWith(u)
+		validateSet(t, w.ToSet(), v.List())
+		w.SymmetricDifferenceWith(c)
+		v.SymmetricDifferenceWith(u)
+		validateSet(t, w.ToSet(), v.List())
+		w.IntersectWith(b)
+		v.IntersectWith(s)
+		validateSet(t, w.ToSet(), v.List())
+		w.DifferenceWith(c)
+		v.DifferenceWith(u)
+		validateSet(t, w.ToSet(), v.List())
+
+		removed := sorted[:len(sorted)/2]
+		w, v = b.Copy(), s.Copy()
+		w.Remove(removed...)
+		v.Remove(removed...)
+		validateSet(t, w.ToSet(), v.List())
+		w.Add(removed...)
+		validateSet(t, w.ToSet(), s.List())
+
+		data, err := b.MarshalBinary()
+		if err != nil {
+			t.Fatalf("expect no error, but got: %v", err)
+		}
+		d := NewRoaring[T](1)
+		if err := d.UnmarshalBinary(data); err != nil || !d.Equal(b) {
+			t.Errorf("expect binary round trip on the scenario: %d, but got: %v", n, err)
+		}
+	}
+
+	b := NewRoaring(max, min)
+	if !b.Has(min) || !b.Has(max) || b.Has(min+1) || !b.HasAll(min, max) || !b.HasAny(min+1, max) || b.HasAny(min+1) {
+		t.Errorf("unexpected predicates on: %s", b)
+	}
+	if expect := fmt.Sprintf("[%v, %v]", min, max); b.String() != expect {
+		t.Errorf("expect string: %s, but got: %s", expect, b)
+	}
+	if k, ok := b.Pop(); !ok || k != min {
+		t.Errorf("expect to pop the smallest element %v, but got: %v", min, k)
+	}
+	b.Remove(max)
+	if _, ok := b.Pop(); ok || !b.IsEmpty() {
+		t.Errorf("expect empty set, but got: %s", b)
+	}
+	var visited []T
+	NewRoaring(max, min, min+1).EachE(func(i T) error {
+		visited = append(visited, i)
+		if len(visited) == 2 {
+			return ErrBreakEach
+		}
+		return nil
+	})
+	if len(visited) != 2 || visited[0] != min || visited[1] != min+1 {
+		t.Errorf("expect visited: [%v %v], but got: %v", min, min+1, visited)
+	}
+}
+
+func TestRoaring_Zero(t *testing.T) {
+	var b, c Roaring[uint32]
+	if !b.IsEmpty() || b.Has(0) || b.Size() != 0 || !b.Equal(&c) || b.Union(&c).Size() != 0 {
+		t.Errorf("expect zero Roaring to be empty")
+	}
+	if _, ok := b.Pop(); ok {
+		t.Errorf("expect Pop on zero Roaring to fail")
+	}
+	b.Remove(1)
+	b.UnionWith(NewRoaring[uint32](1))
+	if !b.Has(1) || b.String() != "[1]" {
+		t.Errorf("expect [1], but got: %s", b.String())
+	}
+}
+
+func TestRoaring_RunOptimize(t *testing.T) {
+	b := NewRoaring[uint32]()
+	for i := uint32(0); i < 1<<20; i++ {
+		b.Add(i)
+	}
+	before, _ := b.MarshalBinary()
+	b.RunOptimize()
+	after, _ := b.MarshalBinary()
+	if len(after) >= len(before)/100 {
+		t.Errorf("expect runs to compress %d bytes, but got: %d bytes", len(before), len(after))
+	}
+	if b.Size() != 1<<20 || !b.Has(0) || !b.Has(1<<20-1) || b.Has(1<<20) {
+		t.Errorf("unexpected elements after RunOptimize: %d", b.Size())
+	}
+	b.Remove(5)
+	b.Add(1 << 30)
+	if b.Size() != 1<<20 || b.Has(5) || !b.Has(6) || !b.Has(1<<30) {
+		t.Errorf("unexpected elements after mutating optimized Roaring: %d", b.Size())
+	}
+}
+
+func TestContainer_Next(t *testing.T) {
+	values := []uint16{0, 3, 4, 5, 63, 64, 200, 1000}
+	array := &arrayContainer{values: values}
+	testcases := []struct {
+		name string
+		c    container
+	}{
+		{name: "array", c: array},
+		{name: "bitmap", c: newBitmapContainer(array.words())},
+		{name: "run", c: &runContainer{runs: []interval{{0, 0}, {3, 5}, {63, 64}, {200, 200}, {1000, 1000}}}},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		j := 0
+		for x := 0; x < 1<<16; x++ {
+			for j < len(values) && values[j] < uint16(x) {
+				j++
+			}
+			got, ok := tc.c.next(uint16(x))
+			if j == len(values) {
+				if ok {
+					t.Fatalf("expect no next of %d, but got: %d", x, got)
+				}
+				continue
+			}
+			if !ok || got != values[j] {
+				t.Fatalf("expect next of %d: %d, but got: %d, %v", x, values[j], got, ok)
+			}
+		}
+	}
+}
+
+func TestRoaring_UnmarshalBinary(t *testing.T) {
+	b := NewRoaring[uint64](1, 2, 3, 1<<40, math.MaxUint64)
+	for i := uint64(1 << 20); i < 1<<20+5000; i++ {
+		b.Add(i)
+	}
+	c := NewRoaring[uint64](1 << 50)
+	for i := uint64(0); i < 3000; i++ {
+		c.Add(1<<50 + i)
+	}
+	c.RunOptimize()
+	b.UnionWith(c)
+	data, _ := b.MarshalBinary()
+	for i := 0; i < len(data); i++ {
+		d := NewRoaring[uint64](7)
+		if err := d.UnmarshalBinary(data[:i]); !errors.Is(err, ErrInvalidEncoding) {
+			t.Fatalf("expect ErrInvalidEncoding on %d of %d bytes, but got: %v", i, len(data), err)
+		}
+		if !d.Equal(NewRoaring[uint64](7)) {
+			t.Fatalf("expect Roaring to be unchanged on error, but got: %s", d)
+		}
+	}
+
+	testcases := []struct {
+		name string
+		data []byte
+	}{
+		{name: "empty", data: []byte{}},
+		{name: "unknown version", data: []byte{9, 0}},
+		{name: "trailing data", data: append(append([]byte{}, data...), 0)},
+		{name: "unknown kind", data: []byte{1, 1, 0, 7, 1, 0, 0}},
+		{name: "unsorted array", data: []byte{1, 1, 0, 0, 2, 2, 0, 1, 0}},
+		{name: "duplicate key", data: []byte{1, 2, 0, 0, 1, 1, 0, 0, 0, 1, 2, 0}},
+		{name: "overlapping runs", data: []byte{1, 1, 0, 2, 2, 0, 0, 5, 0, 3, 0, 1, 0}},
+		{name: "run overflow", data: []byte{1, 1, 0, 2, 1, 0xff, 0xff, 1, 0}},
+		{name: "key out of range", data: []byte{1, 1, 0x80, 0x80, 0x04, 0, 1, 0, 0}},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		if err := NewRoaring[uint32]().UnmarshalBinary(tc.data); !errors.Is(err, ErrInvalidEncoding) {
+			t.Errorf("expect ErrInvalidEncoding, but got: %v", err)
+		}
+	}
+	if err := NewRoaring[uint32]().UnmarshalBinary([]byte{1, 1, 0, 2, 1, 0, 0, 0xff, 0xff}); err != nil {
+		t.Errorf("expect a full run to be valid, but got: %v", err)
+	}
+}
+
+func TestRoaring_JSON(t *testing.T) {
+	b := NewRoaring[int32](3, math.MinInt32, 1)
+	data, err := json.Marshal(b)
+	if expect := fmt.Sprintf("[%d,1,3]", math.MinInt32); err != nil || string(data) != expect {
+		t.Fatalf("expect json: %s, but got: %s, %v", expect, data, err)
+	}
+	c := NewRoaring[int32](9)
+	if err := json.Unmarshal(data, c); err != nil || !c.Equal(b) {
+		t.Errorf("expect %s, but got: %s, %v", b, c, err)
+	}
+	if data, _ := json.Marshal(NewRoaring[uint32]()); string(data) != "[]" {
+		t.Errorf("expect json: [], but got: %s", data)
+	}
+	if strings.Contains(NewRoaring[uint64](math.MaxUint64).String(), "-") {
+		t.Errorf("expect unsigned string")
+	}
+}
+
+func benchmarkRoaringSets(n int) (Set[uint32], Set[uint32]) {
+	r := rand.New(rand.NewSource(1))
+	s, u := NewWithSize[uint32](n), NewWithSize[uint32](n)
+	for i := 0; i < n; i++ {
+		s.Add(uint32(r.Intn(1 << 24)))
+		u.Add(uint32(r.Intn(1 << 24)))
+	}
+	return s, u
+}
+
+func BenchmarkRoaring_Add(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		r := NewRoaring[uint32]()
+		for x := uint32(0); x < 100000; x++ {
+			r.Add(x * 37)
+		}
+	}
+}
+
+func BenchmarkSet_AddUint32(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		s := New[uint32]()
+		for x := uint32(0); x < 100000; x++ {
+			s.Add(x * 37)
+		}
+	}
+}
+
+func BenchmarkRoaring_Has(b *testing.B) {
+	s, _ := benchmarkRoaringSets(100000)
+	r := NewRoaringFromSet(s)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		r.Has(uint32(i))
+	}
+}
+
+func BenchmarkRoaring_Intersection(b *testing.B) {
+	s, u := benchmarkRoaringSets(100000)
+	r, q := NewRoaringFromSet(s), NewRoaringFromSet(u)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		r.Intersection(q)
+	}
+}
+
+func BenchmarkSet_IntersectionUint32(b *testing.B) {
+	s, u := benchmarkRoaringSets(100000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.Intersection(u)
+	}
+}
@@ -27,7 +27,413 @@ SOFTWARE.
 // Reference: https://en.wikipedia.org/wiki/Set_%28abstract_data_type%29
 package set
 
-import "errors"
+import (
+	"errors"
+	"sort"
+)
 
 // ErrBreakEach breaks that the EachE traverses the elements in the set.
 var ErrBreakEach = errors.New("break each func")
+
+// Set is a collection of T that contains no duplicate elements, without any particular order.
+// It supports typical set operations: Core set-theoretical operations, Static sets, Dynamic
+// sets, Additional operations.
+type Set[T comparable] map[T]struct{}
+
+// New initializes a new Set.
+func New[T comparable](elements ...T) Set[T] {
+	s := Set[T]{}
+	s.Add(elements...)
+	return s
+}
+
+// NewWithSize initializes a new Set with the specified size.
+func NewWithSize[T comparable](size int) Set[T] {
+	return make(map[T]struct{}, size)
+}
+
+// Add adds the elements to Set, if it is not present already.
+func (s Set[T]) Add(elements ...T) {
+	for _, element := range elements {
+		s[element] = struct{}{}
+	}
+}
+
+// Remove removes the element from Set, if it is present.
+func (s Set[T]) Remove(elements ...T) {
+	for _, element := range elements {
+		delete(s, element)
+	}
+}
+
+// Pop returns an arbitrary element of Set, deleting it from Set.
+// The second value is a bool that is true if the elements existed in
+// the Set, and false if not.
+func (s Set[T]) Pop() (T, bool) {
+	for k := range s {
+		delete(s, k)
+		return k, true
+	}
+	var zero T
+	return zero, false
+}
+
+// Size returns the number of elements in Set.
+func (s Set[T]) Size() int {
+	return len(s)
+}
+
+// IsEmpty returns whether the Set is Empty.
+func (s Set[T]) IsEmpty() bool {
+	return len(s) == 0
+}
+
+// Clear removes all items from the Set.
+func (s *Set[T]) Clear() {
+	*s = make(map[T]struct{})
+}
+
+// Has judges the specified element whether exists in the Set.
+// it returns true if existed, and false if not.
+func (s Set[T]) Has(element T) bool {
+	_, ok := s[element]
+	return ok
+}
+
+// HasAll looks for the specified elements to judge
+// whether all exist in the Set.
+// it returns true if existed, and false if not.
+func (s Set[T]) HasAll(elements ...T) bool {
+	for _, element := range elements {
+		if _, ok := s[element]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// HasAny looks for the specified elements to judge
+// whether at least one of the element exists in the Set.
+// it returns true if existed, and false if not.
+func (s Set[T]) HasAny(elements ...T) bool {
+	for _, element := range elements {
+		if _, ok := s[element]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// List returns the all elements as a slice.
+func (s Set[T]) List() []T {
+	var dest []T
+	for k := range s {
+		dest = append(dest, k)
+	}
+	return dest
+}
+
+// SortedList returns the all elements as a slice sorted by less func.
+func (s Set[T]) SortedList(less func(i, j T) bool) []T {
+	dest := s.List()
+	sort.Slice(dest, func(i, j int) bool {
+		return less(dest[i], dest[j])
+	})
+	return dest
+}
+
+// EachE traverses the elements in the Set, calling do func for each
+// Set member. the cycle will be stopped when the do func returns error.
+// if err is ErrBreakEach, break the cycle and return nil,
+// else, break the cycle and return error.
+func (s Set[T]) EachE(do func(i T) error) error {
+	for k := range s {
+		if err := do(k); err != nil {
+			if err == ErrBreakEach {
+				return nil
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// Each traverses the elements in the Set, calling do func for each
+// Set member.
+func (s Set[T]) Each(do func(i T)) {
+	for k := range s {
+		do(k)
+	}
+}
+
+// Union returns the union of Set s and t.
+// For example:
+// s = {a, b, c}
+// t = {a, c, d, e, f}
+// s.Union(t) = {a, b, c, d, e, f}
+// t.Union(s) = {a, b, c, d, e, f}
+// s.Union(t) = t.Union(s)
+func (s Set[T]) Union(t Set[T]) Set[T] {
+	// in order to reduce the number of growing map, copy the largest map here
+	var max, min Set[T]
+	if s.Size() > t.Size() {
+		max = s
+		min = t
+	} else {
+		max = t
+		min = s
+	}
+	if max.Size() == 0 {
+		return New[T]()
+	}
+	u := max.Copy()
+	for k := range min {
+		u[k] = struct{}{}
+	}
+	return u
+}
+
+// Difference returns the difference of Set s and t.
+// For example:
+// s = {a, b, c}
+// t = {a, c, d, e, f}
+// s.Difference(t) = {b}
+// t.Difference(s) = {d, e, f}
+func (s Set[T]) Difference(t Set[T]) Set[T] {
+	u := New[T]()
+	for k := range s {
+		if !t.Has(k) {
+			u.Add(k)
+		}
+	}
+	return u
+}
+
+// Intersection returns the intersection of Set s and t.
+// For example:
+// s = {a, b, c}
+// t = {a, c, d, e, f}
+// s.Intersection(t) = {a, c}
+// t.Intersection(s) = {a, c}
+// s.Intersection(t) = t.Intersection(s)
+func (s Set[T]) Intersection(t Set[T]) Set[T] {
+	var max, min Set[T]
+	if s.Size() > t.Size() {
+		max = s
+		min = t
+	} else {
+		max = t
+		min = s
+	}
+	u := New[T]()
+	if min.Size() > 0 {
+		for k := range min {
+			if max.Has(k) {
+				u[k] = struct{}{}
+			}
+		}
+	}
+	return u
+}
+
+// SymmetricDifference returns a new Set with the elements that are either in this Set
+// or in the given Set, but not in both.
+// For example:
+// s = {a, c}
+// t = {a, b, d}
+// s.SymmetricDifference(t) = {c, b, d}
+// t.SymmetricDifference(s) = {c, b, d}
+// s.SymmetricDifference(t) = t.SymmetricDifference(s)
+func (s Set[T]) SymmetricDifference(t Set[T]) Set[T] {
+	u := s.Copy()
+	u.SymmetricDifferenceWith(t)
+	return u
+}
+
+// UnionWith adds all elements of Set t to Set s, it is the in-place
+// version of Union which reuses s instead of allocating a new Set.
+// For example:
+// s = {a, b, c}
+// t = {a, c, d}
+// s.UnionWith(t), s = {a, b, c, d}
+func (s Set[T]) UnionWith(t Set[T]) {
+	for k := range t {
+		s[k] = struct{}{}
+	}
+}
+
+// DifferenceWith removes all elements of Set t from Set s, it is the in-place
+// version of Difference which reuses s instead of allocating a new Set.
+// For example:
+// s = {a, b, c}
+// t = {a, c, d}
+// s.DifferenceWith(t), s = {b}
+func (s Set[T]) DifferenceWith(t Set[T]) {
+	// iterate the smaller one, deleting an absent key is a no-op
+	if len(s) < len(t) {
+		for k := range s {
+			if t.Has(k) {
+				delete(s, k)
+			}
+		}
+		return
+	}
+	for k := range t {
+		delete(s, k)
+	}
+}
+
+// IntersectWith removes the elements of Set s which are not in Set t, it is
+// the in-place version of Intersection which reuses s instead of allocating
+// a new Set.
+// For example:
+// s = {a, b, c}
+// t = {a, c, d}
+// s.IntersectWith(t), s = {a, c}
+func (s Set[T]) IntersectWith(t Set[T]) {
+	for k := range s {
+		if !t.Has(k) {
+			delete(s, k)
+		}
+	}
+}
+
+// SymmetricDifferenceWith keeps the elements that are either in Set s or in
+// Set t, but not in both, it is the in-place version of SymmetricDifference
+// which reuses s instead of allocating a new Set.
+// For example:
+// s = {a, c}
+// t = {a, b, d}
+// s.SymmetricDifferenceWith(t), s = {c, b, d}
+func (s Set[T]) SymmetricDifferenceWith(t Set[T]) {
+	for k := range t {
+		if s.Has(k) {
+			delete(s, k)
+		} else {
+			s[k] = struct{}{}
+		}
+	}
+}
+
+// IsSubset predicates that tests whether the Set s is a subset of Set t.
+// For example:
+// s is subset of t
+// s = {a, b, c}
+// t = {a, b, c, d}
+// s is not subset of t
+// s = {a, f}
+// t = {a, b, c, d}
+func (s Set[T]) IsSubset(t Set[T]) bool {
+	for k := range s {
+		if !t.Has(k) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSuperset predicates that tests whether the Set s is a super of Set t.
+// For example:
+// s is super of t
+// s = {a, b, c, d}
+// t = {a, b, c}
+// s is not super of t
+// s = {a, f}
+// t = {a, b, c, d}
+func (s Set[T]) IsSuperset(t Set[T]) bool {
+	return t.IsSubset(s)
+}
+
+// Equal predicates that tests whether the Set s equals of Set t.
+// For example:
+// s equals of t
+// s = {a, b, c}
+// t = {a, b, c}
+// s does not equal of t
+// s = {a, f}
+// t = {a, b, c, d}
+func (s Set[T]) Equal(t Set[T]) bool {
+	return len(s) == len(t) && s.IsSubset(t)
+}
+
+// Copy returns new Set that clones from Set.
+func (s Set[T]) Copy() Set[T] {
+	t := NewWithSize[T](len(s))
+	for k := range s {
+		t[k] = struct{}{}
+	}
+	return t
+}
+
+// String returns a string representation of Set, the elements are in their
+// natural order so that the output is the same between runs.
+func (s Set[T]) String() string {
+	v := s.List()
+	sortElements(v)
+	return joinElements(v, "%v")
+}
+
+// UnionAll returns the union of all the sets.
+// For example:
+// a = {a, b}
+// b = {b, c}
+// c = {d}
+// UnionAll(a, b, c) = {a, b, c, d}
+func UnionAll[T comparable](sets ...Set[T]) Set[T] {
+	// the sum of sizes is the upper bound of the union, pre-sizing with it
+	// means the result never grows
+	size := 0
+	for _, s := range sets {
+		size += len(s)
+	}
+	u := NewWithSize[T](size)
+	for _, s := range sets {
+		u.UnionWith(s)
+	}
+	return u
+}
+
+// IntersectAll returns the intersection of all the sets, it returns an
+// empty Set when no set is given.
+// For example:
+// a = {a, b, c}
+// b = {b, c, d}
+// c = {c, d}
+// IntersectAll(a, b, c) = {c}
+func IntersectAll[T comparable](sets ...Set[T]) Set[T] {
+	if len(sets) == 0 {
+		return New[T]()
+	}
+	// iterate from the smallest set, the intersection is no larger than it
+	// and every later IntersectWith only scans the shrinking result
+	sorted := make([]Set[T], len(sets))
+	copy(sorted, sets)
+	sort.Slice(sorted, func(i, j int) bool {
+		return len(sorted[i]) < len(sorted[j])
+	})
+	u := sorted[0].Copy()
+	for _, s := range sorted[1:] {
+		if len(u) == 0 {
+			break
+		}
+		u.IntersectWith(s)
+	}
+	return u
+}
+
+// DifferenceAll returns the elements of Set s which are in none of the sets.
+// For example:
+// s = {a, b, c, d}
+// a = {a}
+// b = {c, e}
+// DifferenceAll(s, a, b) = {b, d}
+func DifferenceAll[T comparable](s Set[T], sets ...Set[T]) Set[T] {
+	u := s.Copy()
+	for _, t := range sets {
+		if len(u) == 0 {
+			break
+		}
+		u.DifferenceWith(t)
+	}
+	return u
+}
@@ -0,0 +1,1104 @@
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package set
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// suite runs the table driven Set tests against a particular element type.
+// v holds ten distinct elements, the test cases refer to them by index so
+// that the same table can be shared by every element type.
+type suite[T comparable] struct {
+	name string
+	v    [10]T
+}
+
+func (st suite[T]) elements(indexes ...int) []T {
+	dest := make([]T, 0, len(indexes))
+	for _, i := range indexes {
+		dest = append(dest, st.v[i])
+	}
+	return dest
+}
+
+func (st suite[T]) set(indexes ...int) Set[T] {
+	s := Set[T]{}
+	for _, i := range indexes {
+		s[st.v[i]] = struct{}{}
+	}
+	return s
+}
+
+func TestSet(t *testing.T) {
+	runSuite(t, suite[interface{}]{name: "Interface", v: [10]interface{}{0, "1", 2.0, int8(3), "4", uint(5), 6, "7", 8.5, true}})
+	runSuite(t, suite[string]{name: "String", v: [10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"}})
+	runSuite(t, suite[int]{name: "Int", v: [10]int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}})
+	runSuite(t, suite[int8]{name: "Int8", v: [10]int8{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}})
+	runSuite(t, suite[int16]{name: "Int16", v: [10]int16{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}})
+	runSuite(t, suite[int32]{name: "Int32", v: [10]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}})
+	runSuite(t, suite[int64]{name: "Int64", v: [10]int64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}})
+	runSuite(t, suite[float32]{name: "Float32", v: [10]float32{0, 1.1, 2.2, 3.3, 4.4, 5.5, 6.6, 7.7, 8.8, 9.9}})
+	runSuite(t, suite[float64]{name: "Float64", v: [10]float64{0, 1.1, 2.2, 3.3, 4.4, 5.5, 6.6, 7.7, 8.8, 9.9}})
+	runSuite(t, suite[uint]{name: "Uint", v: [10]uint{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}})
+	runSuite(t, suite[uint8]{name: "Uint8", v: [10]uint8{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}})
+	runSuite(t, suite[uint16]{name: "Uint16", v: [10]uint16{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}})
+	runSuite(t, suite[uint32]{name: "Uint32", v: [10]uint32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}})
+	runSuite(t, suite[uint64]{name: "Uint64", v: [10]uint64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}})
+	runSuite(t, suite[uintptr]{name: "Uintptr", v: [10]uintptr{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}})
+}
+
+func runSuite[T comparable](t *testing.T, st suite[T]) {
+	t.Run(st.name, func(t *testing.T) {
+		t.Run("New", func(t *testing.T) { testNew(t, st) })
+		t.Run("Add", func(t *testing.T) { testAdd(t, st) })
+		t.Run("Remove", func(t *testing.T) { testRemove(t, st) })
+		t.Run("Pop", func(t *testing.T) { testPop(t, st) })
+		t.Run("Size", func(t *testing.T) { testSize(t, st) })
+		t.Run("IsEmpty", func(t *testing.T) { testIsEmpty(t, st) })
+		t.Run("Clear", func(t *testing.T) { testClear(t, st) })
+		t.Run("Has", func(t *testing.T) { testHas(t, st) })
+		t.Run("HasAll", func(t *testing.T) { testHasAll(t, st) })
+		t.Run("HasAny", func(t *testing.T) { testHasAny(t, st) })
+		t.Run("List", func(t *testing.T) { testList(t, st) })
+		t.Run("SortedList", func(t *testing.T) { testSortedList(t, st) })
+		t.Run("Each", func(t *testing.T) { testEach(t, st) })
+		t.Run("EachE", func(t *testing.T) { testEachE(t, st) })
+		t.Run("Union", func(t *testing.T) { testUnion(t, st) })
+		t.Run("Difference", func(t *testing.T) { testDifference(t, st) })
+		t.Run("Intersection", func(t *testing.T) { testIntersection(t, st) })
+		t.Run("SymmetricDifference", func(t *testing.T) { testSymmetricDifference(t, st) })
+		t.Run("InPlace", func(t *testing.T) { testInPlace(t, st) })
+		t.Run("UnionAll", func(t *testing.T) { testUnionAll(t, st) })
+		t.Run("IntersectAll", func(t *testing.T) { testIntersectAll(t, st) })
+		t.Run("DifferenceAll", func(t *testing.T) { testDifferenceAll(t, st) })
+		t.Run("IsSubset", func(t *testing.T) { testIsSubset(t, st) })
+		t.Run("IsSuperset", func(t *testing.T) { testIsSuperset(t, st) })
+		t.Run("Equal", func(t *testing.T) { testEqual(t, st) })
+		t.Run("Copy", func(t *testing.T) { testCopy(t, st) })
+		t.Run("String", func(t *testing.T) { testString(t, st) })
+	})
+}
+
+func testNew[T comparable](t *testing.T, st suite[T]) {
+	testCases := []struct {
+		name   string
+		input  []int
+		expect []int
+	}{
+		{
+			name:   "s nothing",
+			input:  nil,
+			expect: nil,
+		},
+		{
+			name:   "inputs multiple elements",
+			input:  []int{1, 2, 3},
+			expect: []int{1, 2, 3},
+		},
+		{
+			name:   "inputs duplicate elements",
+			input:  []int{1, 2, 2, 3, 1},
+			expect: []int{1, 2, 3},
+		},
+	}
+	for _, tc := range testCases {
+		t.Logf("running scenario: %s", tc.name)
+		actual := New(st.elements(tc.input...)...)
+		validateSet(t, actual, st.elements(tc.expect...))
+		sized := NewWithSize[T](len(tc.input))
+		sized.Add(st.elements(tc.input...)...)
+		validateSet(t, sized, st.elements(tc.expect...))
+	}
+}
+
+func testAdd[T comparable](t *testing.T, st suite[T]) {
+	testcases := []struct {
+		name   string
+		input  []int
+		expect []int
+	}{
+		{
+			name:   "inputs nothing",
+			input:  []int{},
+			expect: []int{},
+		},
+		{
+			name:   "inputs multiple elements",
+			input:  []int{1, 2, 3},
+			expect: []int{1, 2, 3},
+		},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		actual := Set[T]{}
+		actual.Add(st.elements(tc.input...)...)
+		validateSet(t, actual, st.elements(tc.expect...))
+	}
+}
+
+func testRemove[T comparable](t *testing.T, st suite[T]) {
+	testcases := []struct {
+		name   string
+		s      []int
+		input  []int
+		expect []int
+	}{
+		{
+			name:   "inputs nothing",
+			s:      []int{1, 2, 3},
+			input:  []int{},
+			expect: []int{1, 2, 3},
+		},
+		{
+			name:   "inputs multiple exit elements",
+			s:      []int{1, 2, 3},
+			input:  []int{1, 3},
+			expect: []int{2},
+		},
+		{
+			name:   "inputs multiple non-exit elements",
+			s:      []int{1, 2, 3},
+			input:  []int{0, 6, 7},
+			expect: []int{1, 2, 3},
+		},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		s := st.set(tc.s...)
+		s.Remove(st.elements(tc.input...)...)
+		validateSet(t, s, st.elements(tc.expect...))
+	}
+}
+
+func testPop[T comparable](t *testing.T, st suite[T]) {
+	testcases := []struct {
+		name   string
+		s      []int
+		expect bool
+	}{
+		{
+			name:   "s is not empty",
+			s:      []int{1, 2, 3},
+			expect: true,
+		},
+		{
+			name:   "s is empty",
+			s:      []int{},
+			expect: false,
+		},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		s := st.set(tc.s...)
+		backup := s.Copy()
+		key, ok := s.Pop()
+		if ok != tc.expect {
+			t.Errorf("expect ok: %v, but got: %v", tc.expect, ok)
+		}
+		if !ok {
+			var zero T
+			if key != zero {
+				t.Errorf("expect zero value, but got: %v", key)
+			}
+		}
+		delete(backup, key)
+		validateSet(t, s, backup.List())
+	}
+}
+
+func testSize[T comparable](t *testing.T, st suite[T]) {
+	testcases := []struct {
+		name   string
+		s      []int
+		expect int
+	}{
+		{
+			name:   "s is empty",
+			s:      []int{},
+			expect: 0,
+		},
+		{
+			name:   "s is not empty",
+			s:      []int{1, 2, 3},
+			expect: 3,
+		},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		s := st.set(tc.s...)
+		if s.Size() != tc.expect {
+			t.Errorf("expect size: %d, but got: %d", tc.expect, s.Size())
+		}
+	}
+}
+
+func testIsEmpty[T comparable](t *testing.T, st suite[T]) {
+	testcases := []struct {
+		name   string
+		s      []int
+		expect bool
+	}{
+		{
+			name:   "s is empty",
+			s:      []int{},
+			expect: true,
+		},
+		{
+			name:   "s is not empty",
+			s:      []int{1, 2, 3},
+			expect: false,
+		},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		actual := st.set(tc.s...).IsEmpty()
+		if actual != tc.expect {
+			t.Errorf("expect return: %v, but got: %v", tc.expect, actual)
+		}
+	}
+}
+
+func testClear[T comparable](t *testing.T, st suite[T]) {
+	testcases := []struct {
+		name string
+		s    []int
+	}{
+		{
+			name: "s is empty",
+			s:    []int{},
+		},
+		{
+			name: "s is not empty",
+			s:    []int{1, 2, 3},
+		},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		s := st.set(tc.s...)
+		s.Clear()
+		if len(s) != 0 {
+			t.Errorf("expect empty, but got: %s", s)
+		}
+	}
+}
+
+func testHas[T comparable](t *testing.T, st suite[T]) {
+	testcases := []struct {
+		name   string
+		s      []int
+		input  int
+		expect bool
+	}{
+		{
+			name:   "s has input element",
+			s:      []int{1, 2, 3},
+			input:  1,
+			expect: true,
+		},
+		{
+			name:   "s does not have element",
+			s:      []int{1, 2, 3},
+			input:  4,
+			expect: false,
+		},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		actual := st.set(tc.s...).Has(st.v[tc.input])
+		if actual != tc.expect {
+			t.Errorf("expect return: %v, but got: %v", tc.expect, actual)
+		}
+	}
+}
+
+func testHasAll[T comparable](t *testing.T, st suite[T]) {
+	testcases := []struct {
+		name   string
+		s      []int
+		input  []int
+		expect bool
+	}{
+		{
+			name:   "set has all input elements",
+			s:      []int{1, 2, 3},
+			input:  []int{1, 2},
+			expect: true,
+		},
+		{
+			name:   "set does not have all input elements",
+			s:      []int{1, 2, 3},
+			input:  []int{8, 9},
+			expect: false,
+		},
+		{
+			name:   "set does not have all input elements, but exist elements in set",
+			s:      []int{1, 2, 3},
+			input:  []int{1, 9},
+			expect: false,
+		},
+		{
+			name:   "input empty",
+			s:      []int{1, 2, 3},
+			input:  []int{},
+			expect: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		actual := st.set(tc.s...).HasAll(st.elements(tc.input...)...)
+		if actual != tc.expect {
+			t.Errorf("expect return: %v, but got: %v", tc.expect, actual)
+		}
+	}
+}
+
+func testHasAny[T comparable](t *testing.T, st suite[T]) {
+	testcases := []struct {
+		name   string
+		s      []int
+		input  []int
+		expect bool
+	}{
+		{
+			name:   "s has all elements",
+			s:      []int{1, 2, 3},
+			input:  []int{1, 2},
+			expect: true,
+		},
+		{
+			name:   "s does not have all elements, but exist elements in set",
+			s:      []int{1, 2, 3},
+			input:  []int{1, 9},
+			expect: true,
+		},
+		{
+			name:   "s does not has all elements",
+			s:      []int{1, 2, 3},
+			input:  []int{8, 9},
+			expect: false,
+		},
+		{
+			name:   "input empty",
+			s:      []int{1, 2, 3},
+			input:  []int{},
+			expect: false,
+		},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		actual := st.set(tc.s...).HasAny(st.elements(tc.input...)...)
+		if actual != tc.expect {
+			t.Errorf("expect return: %v, but got: %v", tc.expect, actual)
+		}
+	}
+}
+
+func testList[T comparable](t *testing.T, st suite[T]) {
+	testcases := []struct {
+		name string
+		s    []int
+	}{
+		{
+			name: "s is empty",
+			s:    []int{},
+		},
+		{
+			name: "s is not empty",
+			s:    []int{1, 2, 3},
+		},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		s := st.set(tc.s...)
+		actual := s.List()
+		if len(actual) != len(s) {
+			t.Errorf("expect list len: %d, but got: %d", len(s), len(actual))
+		}
+		validateSet(t, s, actual)
+	}
+}
+
+func testSortedList[T comparable](t *testing.T, st suite[T]) {
+	index := make(map[T]int, len(st.v))
+	for i, v := range st.v {
+		index[v] = i
+	}
+	testcases := []struct {
+		name   string
+		s      []int
+		expect []int
+	}{
+		{
+			name: "s is empty",
+			s:    []int{},
+		},
+		{
+			name:   "s is not empty",
+			s:      []int{3, 1, 2},
+			expect: []int{1, 2, 3},
+		},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		actual := st.set(tc.s...).SortedList(func(i, j T) bool {
+			return index[i] < index[j]
+		})
+		expect := st.elements(tc.expect...)
+		if len(actual) != len(expect) {
+			t.Errorf("expect set len: %d, but got: %d", len(expect), len(actual))
+			continue
+		}
+		for i := 0; i < len(expect); i++ {
+			if actual[i] != expect[i] {
+				t.Errorf("expect slice: %v, but got: %v", expect, actual)
+			}
+		}
+	}
+}
+
+func testEach[T comparable](t *testing.T, st suite[T]) {
+	testcases := []struct {
+		name   string
+		origin []int
+	}{
+		{
+			name:   "s is not empty",
+			origin: []int{1, 2, 3},
+		},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		s := st.set(tc.origin...)
+		var expect []T
+		s.Each(func(i T) {
+			expect = append(expect, i)
+		})
+		validateSet(t, s, expect)
+	}
+}
+
+func testEachE[T comparable](t *testing.T, st suite[T]) {
+	inputErr := errors.New("s error")
+	testcases := []struct {
+		name      string
+		origin    []int
+		breakEach bool
+		inputErr  error
+		expectLen int
+		expectErr error
+	}{
+		{
+			name:      "traverses all elements",
+			origin:    []int{1, 2, 3},
+			expectLen: 3,
+		},
+		{
+			name:      "return break error",
+			origin:    []int{1, 2, 3},
+			breakEach: true,
+			expectLen: 1,
+		},
+		{
+			name:      "returns error",
+			origin:    []int{1, 2, 3},
+			inputErr:  inputErr,
+			expectErr: inputErr,
+			expectLen: 1,
+		},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		s := st.set(tc.origin...)
+		var visited []T
+		err := s.EachE(func(i T) error {
+			visited = append(visited, i)
+			if tc.breakEach {
+				return ErrBreakEach
+			}
+			return tc.inputErr
+		})
+		if err != tc.expectErr {
+			t.Errorf("expect error: %v, but got: %v", tc.expectErr, err)
+		}
+		if len(visited) != tc.expectLen {
+			t.Errorf("expect visited len: %d, but got: %d", tc.expectLen, len(visited))
+		}
+	}
+}
+
+func testUnion[T comparable](t *testing.T, st suite[T]) {
+	testcases := []struct {
+		name   string
+		s      []int
+		t      []int
+		expect []int
+	}{
+		{
+			name:   "s and t are empty",
+			s:      []int{},
+			t:      []int{},
+			expect: []int{},
+		},
+		{
+			name:   "s is empty",
+			s:      []int{},
+			t:      []int{1, 2, 3},
+			expect: []int{1, 2, 3},
+		},
+		{
+			name:   "t is empty",
+			s:      []int{1, 2, 3},
+			t:      []int{},
+			expect: []int{1, 2, 3},
+		},
+		{
+			name:   "s has same element to t",
+			s:      []int{1, 2, 3},
+			t:      []int{1, 9, 4},
+			expect: []int{1, 2, 3, 4, 9},
+		},
+		{
+			name:   "s does not have same element to t",
+			s:      []int{1, 2, 3},
+			t:      []int{6, 7, 8},
+			expect: []int{1, 2, 3, 6, 7, 8},
+		},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		s, u := st.set(tc.s...), st.set(tc.t...)
+		actual := s.Union(u)
+		validateSet(t, actual, st.elements(tc.expect...))
+		validateSet(t, s, st.elements(tc.s...))
+		validateSet(t, u, st.elements(tc.t...))
+	}
+}
+
+func testDifference[T comparable](t *testing.T, st suite[T]) {
+	testcases := []struct {
+		name   string
+		s      []int
+		t      []int
+		expect []int
+	}{
+		{
+			name:   "s is empty",
+			s:      []int{},
+			t:      []int{2, 9, 4},
+			expect: []int{},
+		},
+		{
+			name:   "t is empty",
+			s:      []int{2, 9, 4},
+			t:      []int{},
+			expect: []int{2, 9, 4},
+		},
+		{
+			name:   "s ⊂ t",
+			s:      []int{2, 9},
+			t:      []int{2, 9, 4},
+			expect: []int{},
+		},
+		{
+			name:   "s ⊃ t",
+			s:      []int{2, 9, 4},
+			t:      []int{2},
+			expect: []int{9, 4},
+		},
+		{
+			name:   "s = t",
+			s:      []int{2, 9, 4},
+			t:      []int{2, 9, 4},
+			expect: []int{},
+		},
+		{
+			name:   "s ∩ t = Ø",
+			s:      []int{2, 9, 4},
+			t:      []int{1, 6},
+			expect: []int{2, 9, 4},
+		},
+		{
+			name:   "s ∩ t ≠ Ø",
+			s:      []int{2, 9, 4},
+			t:      []int{2, 9, 5},
+			expect: []int{4},
+		},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		actual := st.set(tc.s...).Difference(st.set(tc.t...))
+		validateSet(t, actual, st.elements(tc.expect...))
+	}
+}
+
+func testIntersection[T comparable](t *testing.T, st suite[T]) {
+	testcases := []struct {
+		name   string
+		s      []int
+		t      []int
+		expect []int
+	}{
+		{
+			name:   "s is empty",
+			s:      []int{},
+			t:      []int{2, 9, 4},
+			expect: []int{},
+		},
+		{
+			name:   "t is empty",
+			s:      []int{2, 9, 4},
+			t:      []int{},
+			expect: []int{},
+		},
+		{
+			name:   "s ⊂ t",
+			s:      []int{2, 9},
+			t:      []int{2, 9, 4},
+			expect: []int{2, 9},
+		},
+		{
+			name:   "s ⊃ t",
+			s:      []int{2, 9, 4},
+			t:      []int{2, 9},
+			expect: []int{2, 9},
+		},
+		{
+			name:   "s = t",
+			s:      []int{2, 9, 4},
+			t:      []int{2, 9, 4},
+			expect: []int{2, 9, 4},
+		},
+		{
+			name:   "s ∩ t = Ø",
+			s:      []int{1, 4},
+			t:      []int{2, 6},
+			expect: []int{},
+		},
+		{
+			name:   "s ∩ t ≠ Ø",
+			s:      []int{1, 4},
+			t:      []int{1, 6},
+			expect: []int{1},
+		},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		actual := st.set(tc.s...).Intersection(st.set(tc.t...))
+		validateSet(t, actual, st.elements(tc.expect...))
+	}
+}
+
+func testSymmetricDifference[T comparable](t *testing.T, st suite[T]) {
+	testcases := []struct {
+		name   string
+		s      []int
+		t      []int
+		expect []int
+	}{
+		{
+			name:   "s is empty",
+			s:      []int{},
+			t:      []int{2, 9, 4},
+			expect: []int{2, 9, 4},
+		},
+		{
+			name:   "t is empty",
+			s:      []int{2, 9, 4},
+			t:      []int{},
+			expect: []int{2, 9, 4},
+		},
+		{
+			name:   "s ⊂ t",
+			s:      []int{2, 9},
+			t:      []int{2, 9, 4},
+			expect: []int{4},
+		},
+		{
+			name:   "s ⊃ t",
+			s:      []int{2, 9, 4},
+			t:      []int{2, 9},
+			expect: []int{4},
+		},
+		{
+			name:   "s = t",
+			s:      []int{2, 9, 4},
+			t:      []int{2, 9, 4},
+			expect: []int{},
+		},
+		{
+			name:   "s ∩ t = Ø",
+			s:      []int{1, 4},
+			t:      []int{2, 6},
+			expect: []int{1, 2, 4, 6},
+		},
+		{
+			name:   "s ∩ t ≠ Ø",
+			s:      []int{1, 4},
+			t:      []int{1, 6},
+			expect: []int{4, 6},
+		},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		actual := st.set(tc.s...).SymmetricDifference(st.set(tc.t...))
+		validateSet(t, actual, st.elements(tc.expect...))
+	}
+}
+
+func testInPlace[T comparable](t *testing.T, st suite[T]) {
+	testcases := []struct {
+		name string
+		s    []int
+		t    []int
+	}{
+		{name: "s and t are empty", s: []int{}, t: []int{}},
+		{name: "s is empty", s: []int{}, t: []int{2, 9, 4}},
+		{name: "t is empty", s: []int{2, 9, 4}, t: []int{}},
+		{name: "s ⊂ t", s: []int{2, 9}, t: []int{2, 9, 4}},
+		{name: "s ⊃ t", s: []int{2, 9, 4}, t: []int{2, 9}},
+		{name: "s = t", s: []int{2, 9, 4}, t: []int{2, 9, 4}},
+		{name: "s ∩ t = Ø", s: []int{1, 4}, t: []int{2, 6}},
+		{name: "s ∩ t ≠ Ø", s: []int{1, 4, 5, 7}, t: []int{1, 6}},
+	}
+	operations := []struct {
+		name     string
+		inPlace  func(s, t Set[T])
+		allocate func(s, t Set[T]) Set[T]
+	}{
+		{name: "UnionWith", inPlace: Set[T].UnionWith, allocate: Set[T].Union},
+		{name: "DifferenceWith", inPlace: Set[T].DifferenceWith, allocate: Set[T].Difference},
+		{name: "IntersectWith", inPlace: Set[T].IntersectWith, allocate: Set[T].Intersection},
+		{name: "SymmetricDifferenceWith", inPlace: Set[T].SymmetricDifferenceWith, allocate: Set[T].SymmetricDifference},
+	}
+	for _, op := range operations {
+		for _, tc := range testcases {
+			t.Logf("running scenario: %s, %s", op.name, tc.name)
+			expect := op.allocate(st.set(tc.s...), st.set(tc.t...))
+			s, u := st.set(tc.s...), st.set(tc.t...)
+			op.inPlace(s, u)
+			validateSet(t, s, expect.List())
+			validateSet(t, u, st.elements(tc.t...))
+
+			// the receiver and the argument may be the same set.
+			self := st.set(tc.s...)
+			op.inPlace(self, self)
+			validateSet(t, self, op.allocate(st.set(tc.s...), st.set(tc.s...)).List())
+		}
+	}
+}
+
+func (st suite[T]) sets(indexes ...[]int) []Set[T] {
+	dest := make([]Set[T], 0, len(indexes))
+	for _, i := range indexes {
+		dest = append(dest, st.set(i...))
+	}
+	return dest
+}
+
+func testUnionAll[T comparable](t *testing.T, st suite[T]) {
+	testcases := []struct {
+		name   string
+		sets   [][]int
+		expect []int
+	}{
+		{
+			name:   "no set",
+			sets:   nil,
+			expect: []int{},
+		},
+		{
+			name:   "one set",
+			sets:   [][]int{{1, 2}},
+			expect: []int{1, 2},
+		},
+		{
+			name:   "sets are empty",
+			sets:   [][]int{{}, {}, {}},
+			expect: []int{},
+		},
+		{
+			name:   "sets overlap",
+			sets:   [][]int{{1, 2}, {2, 3}, {}, {3, 4, 5}},
+			expect: []int{1, 2, 3, 4, 5},
+		},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		sets := st.sets(tc.sets...)
+		actual := UnionAll(sets...)
+		validateSet(t, actual, st.elements(tc.expect...))
+		for i, s := range sets {
+			validateSet(t, s, st.elements(tc.sets[i]...))
+		}
+	}
+}
+
+func testIntersectAll[T comparable](t *testing.T, st suite[T]) {
+	testcases := []struct {
+		name   string
+		sets   [][]int
+		expect []int
+	}{
+		{
+			name:   "no set",
+			sets:   nil,
+			expect: []int{},
+		},
+		{
+			name:   "one set",
+			sets:   [][]int{{1, 2}},
+			expect: []int{1, 2},
+		},
+		{
+			name:   "one of sets is empty",
+			sets:   [][]int{{1, 2}, {}, {1, 2}},
+			expect: []int{},
+		},
+		{
+			name:   "sets overlap",
+			sets:   [][]int{{1, 2, 3, 4}, {2, 3, 4, 5}, {3, 4}},
+			expect: []int{3, 4},
+		},
+		{
+			name:   "intermediate intersection becomes empty",
+			sets:   [][]int{{1, 2}, {3, 4}, {1, 2, 3, 4, 5}},
+			expect: []int{},
+		},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		sets := st.sets(tc.sets...)
+		actual := IntersectAll(sets...)
+		validateSet(t, actual, st.elements(tc.expect...))
+		for i, s := range sets {
+			validateSet(t, s, st.elements(tc.sets[i]...))
+		}
+	}
+}
+
+func testDifferenceAll[T comparable](t *testing.T, st suite[T]) {
+	testcases := []struct {
+		name   string
+		s      []int
+		sets   [][]int
+		expect []int
+	}{
+		{
+			name:   "no set",
+			s:      []int{1, 2},
+			expect: []int{1, 2},
+		},
+		{
+			name:   "s is empty",
+			s:      []int{},
+			sets:   [][]int{{1, 2}},
+			expect: []int{},
+		},
+		{
+			name:   "sets overlap",
+			s:      []int{1, 2, 3, 4},
+			sets:   [][]int{{1}, {3, 5}, {}},
+			expect: []int{2, 4},
+		},
+		{
+			name:   "sets cover s",
+			s:      []int{1, 2, 3, 4},
+			sets:   [][]int{{1, 2}, {3, 4}, {5}},
+			expect: []int{},
+		},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		s := st.set(tc.s...)
+		actual := DifferenceAll(s, st.sets(tc.sets...)...)
+		validateSet(t, actual, st.elements(tc.expect...))
+		validateSet(t, s, st.elements(tc.s...))
+	}
+}
+
+// relationCases are shared by the IsSubset, IsSuperset and Equal tests.
+var relationCases = []struct {
+	name string
+	s    []int
+	t    []int
+}{
+	{name: "s is empty", s: []int{}, t: []int{2, 9, 4}},
+	{name: "t is empty", s: []int{2, 9, 4}, t: []int{}},
+	{name: "s ⊂ t", s: []int{2, 9}, t: []int{2, 9, 4}},
+	{name: "s ⊃ t", s: []int{2, 9, 4}, t: []int{2, 9}},
+	{name: "s = t", s: []int{2, 9, 4}, t: []int{2, 9, 4}},
+	{name: "s ∩ t = Ø", s: []int{1, 4}, t: []int{2, 6}},
+	{name: "s ∩ t ≠ Ø && s ∩ t ≠ s", s: []int{1, 4}, t: []int{1, 6}},
+}
+
+func testIsSubset[T comparable](t *testing.T, st suite[T]) {
+	expects := []bool{true, false, true, false, true, false, false}
+	for i, tc := range relationCases {
+		t.Logf("running scenario: %s", tc.name)
+		actual := st.set(tc.s...).IsSubset(st.set(tc.t...))
+		if actual != expects[i] {
+			t.Errorf("expect return: %v, but got: %v", expects[i], actual)
+		}
+	}
+}
+
+func testIsSuperset[T comparable](t *testing.T, st suite[T]) {
+	expects := []bool{false, true, false, true, true, false, false}
+	for i, tc := range relationCases {
+		t.Logf("running scenario: %s", tc.name)
+		actual := st.set(tc.s...).IsSuperset(st.set(tc.t...))
+		if actual != expects[i] {
+			t.Errorf("expect return: %v, but got: %v", expects[i], actual)
+		}
+	}
+}
+
+func testEqual[T comparable](t *testing.T, st suite[T]) {
+	expects := []bool{false, false, false, false, true, false, false}
+	for i, tc := range relationCases {
+		t.Logf("running scenario: %s", tc.name)
+		actual := st.set(tc.s...).Equal(st.set(tc.t...))
+		if actual != expects[i] {
+			t.Errorf("expect return: %v, but got: %v", expects[i], actual)
+		}
+	}
+}
+
+func testCopy[T comparable](t *testing.T, st suite[T]) {
+	testcases := []struct {
+		name   string
+		s      []int
+		expect []int
+	}{
+		{
+			name:   "s is empty",
+			s:      []int{},
+			expect: []int{},
+		},
+		{
+			name:   "s is not empty",
+			s:      []int{2, 9, 4},
+			expect: []int{2, 9, 4},
+		},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		s := st.set(tc.s...)
+		actual := s.Copy()
+		validateSet(t, actual, st.elements(tc.expect...))
+		actual.Add(st.v[0])
+		if s.Has(st.v[0]) {
+			t.Errorf("expect copy to be independent of origin set: %s", s)
+		}
+	}
+}
+
+func testString[T comparable](t *testing.T, st suite[T]) {
+	testcases := []struct {
+		name   string
+		s      []int
+		expect string
+	}{
+		{
+			name:   "s is empty",
+			s:      []int{},
+			expect: "[]",
+		},
+		{
+			name:   "s is not empty",
+			s:      []int{1},
+			expect: fmt.Sprintf("[%v]", st.v[1]),
+		},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		actual := st.set(tc.s...).String()
+		if actual != tc.expect {
+			t.Errorf("expect string: %s, but got: %s", tc.expect, actual)
+		}
+	}
+}
+
+func TestSet_InPlaceAllocs(t *testing.T) {
+	s := NewInt(1, 2, 3, 4, 5, 6, 7, 8)
+	u := NewInt(2, 4, 6, 8)
+	empty := NewInt()
+	allocs := testing.AllocsPerRun(100, func() {
+		s.UnionWith(u)
+		s.SymmetricDifferenceWith(u)
+		s.UnionWith(u)
+		s.IntersectWith(s)
+		s.DifferenceWith(empty)
+	})
+	if allocs != 0 {
+		t.Errorf("expect no allocation, but got: %v", allocs)
+	}
+}
+
+func TestNamedSets(t *testing.T) {
+	validateSet(t, NewInterface(1, "1"), []interface{}{1, "1"})
+	validateSet(t, NewString("a", "b"), []string{"a", "b"})
+	validateSet(t, NewInt(1, 2), []int{1, 2})
+	validateSet(t, NewInt8(1, 2), []int8{1, 2})
+	validateSet(t, NewInt16(1, 2), []int16{1, 2})
+	validateSet(t, NewInt32(1, 2), []int32{1, 2})
+	validateSet(t, NewInt64(1, 2), []int64{1, 2})
+	validateSet(t, NewFloat32(1, 2), []float32{1, 2})
+	validateSet(t, NewFloat64(1, 2), []float64{1, 2})
+	validateSet(t, NewUint(1, 2), []uint{1, 2})
+	validateSet(t, NewUint8(1, 2), []uint8{1, 2})
+	validateSet(t, NewUint16(1, 2), []uint16{1, 2})
+	validateSet(t, NewUint32(1, 2), []uint32{1, 2})
+	validateSet(t, NewUint64(1, 2), []uint64{1, 2})
+	validateSet(t, NewUintptr(1, 2), []uintptr{1, 2})
+
+	// the named sets are plain maps, so map literals keep working.
+	var s Int = map[int]struct{}{1: {}, 2: {}}
+	validateSet(t, s, []int{1, 2})
+	validateSet(t, NewStringWithSize(8), []string{})
+}
+
+func validateSet[T comparable](t *testing.T, actual Set[T], expect []T) {
+	t.Helper()
+	if len(expect) != len(actual) {
+		t.Errorf("expect set len: %d, but got: %d", len(expect), len(actual))
+	}
+	slice2String := func(i []T) string {
+		v := make([]string, 0, len(i))
+		for _, element := range i {
+			v = append(v, fmt.Sprintf("%v", element))
+		}
+		return fmt.Sprintf("[%s]", strings.Join(v, ", "))
+	}
+	for _, k := range expect {
+		if _, ok := actual[k]; !ok {
+			t.Errorf("expect set: %s, but got: %s", slice2String(expect), actual)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package main
+
+import (
+	"errors"
+	"go/ast"
+	"go/build"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// sourceImporter imports the packages used by the generated go files from
+// source, it keeps the packages it imported for the next type-checks.
+var sourceImporter = importer.ForCompiler(token.NewFileSet(), "source", nil)
+
+// maxErrors is the number of type-check errors reported, as the compiler does.
+const maxErrors = 10
+
+// typeCheck type-checks the generated go file src, which is written to the
+// file name, with the other go files of its package in the directory of
+// name. Only the errors in src are returned, and src is not checked if the
+// package has no other go file, as the element types are not declared yet.
+func typeCheck(name string, src []byte) error {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, name, src, 0)
+	if err != nil {
+		return err
+	}
+	dir := filepath.Dir(name)
+	pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		match, err := build.Default.MatchFile(dir, fi.Name())
+		return err == nil && match && !strings.HasSuffix(fi.Name(), "_test.go") && fi.Name() != filepath.Base(name)
+	}, 0)
+	if err != nil {
+		return err
+	}
+	p, ok := pkgs[f.Name.Name]
+	if !ok {
+		return nil
+	}
+	files := []*ast.File{f}
+	for _, pf := range p.Files {
+		files = append(files, pf)
+	}
+	var errs []string
+	conf := types.Config{
+		Importer: sourceImporter,
+		Error: func(err error) {
+			if err, ok := err.(types.Error); ok && err.Fset.Position(err.Pos).Filename == name {
+				errs = append(errs, err.Error())
+			}
+		},
+	}
+	conf.Check(f.Name.Name, fset, files, nil)
+	if len(errs) > maxErrors {
+		errs = append(errs[:maxErrors], "too many errors")
+	}
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
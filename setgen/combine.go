@@ -0,0 +1,155 @@
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"sort"
+	"strings"
+)
+
+// combine merges the go files generated for several types into one: the
+// header and the package clause of the first file, the imports of all the
+// files, and their declarations, the declarations shared by the files, such
+// as the ErrBreakEach of -l, are kept once.
+func combine(files [][]byte) ([]byte, error) {
+	if len(files) == 1 {
+		return files[0], nil
+	}
+	var header []byte
+	var body bytes.Buffer
+	imports := map[string]bool{}
+	declared := map[string]bool{}
+	for i, src := range files {
+		fset := token.NewFileSet()
+		f, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		offset := func(p token.Pos) int {
+			return fset.Position(p).Offset
+		}
+		if i == 0 {
+			header = src[:offset(f.Name.End())]
+		}
+		start := offset(f.Name.End())
+		for _, decl := range f.Decls {
+			d, ok := decl.(*ast.GenDecl)
+			if ok && d.Tok == token.IMPORT {
+				for _, spec := range d.Specs {
+					imports[spec.(*ast.ImportSpec).Path.Value] = true
+				}
+				start = offset(d.End())
+				continue
+			}
+			names := declNames(decl)
+			shared := len(names) > 0
+			for _, name := range names {
+				shared = shared && declared[name]
+				declared[name] = true
+			}
+			if !shared {
+				continue
+			}
+			// skip the declaration and its doc comment
+			pos := decl.Pos()
+			if doc := declDoc(decl); doc != nil {
+				pos = doc.Pos()
+			}
+			body.Write(src[start:offset(pos)])
+			start = offset(decl.End())
+		}
+		body.Write(src[start:])
+	}
+	var std, others []string
+	for path := range imports {
+		if strings.Contains(strings.SplitN(path, "/", 2)[0], ".") {
+			others = append(others, path)
+		} else {
+			std = append(std, path)
+		}
+	}
+	sort.Strings(std)
+	sort.Strings(others)
+	var buf bytes.Buffer
+	buf.Write(header)
+	buf.WriteString("\n\nimport (\n")
+	for _, path := range std {
+		fmt.Fprintf(&buf, "\t%s\n", path)
+	}
+	if len(std) > 0 && len(others) > 0 {
+		buf.WriteString("\n")
+	}
+	for _, path := range others {
+		fmt.Fprintf(&buf, "\t%s\n", path)
+	}
+	buf.WriteString(")\n")
+	buf.Write(body.Bytes())
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("combined go file is invalid: %v", err)
+	}
+	return src, nil
+}
+
+// declNames returns the names declared at package level by decl, methods
+// declare no package level name.
+func declNames(decl ast.Decl) []string {
+	var names []string
+	switch d := decl.(type) {
+	case *ast.FuncDecl:
+		if d.Recv == nil {
+			names = append(names, d.Name.Name)
+		}
+	case *ast.GenDecl:
+		for _, spec := range d.Specs {
+			switch s := spec.(type) {
+			case *ast.TypeSpec:
+				names = append(names, s.Name.Name)
+			case *ast.ValueSpec:
+				for _, name := range s.Names {
+					names = append(names, name.Name)
+				}
+			}
+		}
+	}
+	return names
+}
+
+// declDoc returns the doc comment of decl.
+func declDoc(decl ast.Decl) *ast.CommentGroup {
+	switch d := decl.(type) {
+	case *ast.FuncDecl:
+		return d.Doc
+	case *ast.GenDecl:
+		return d.Doc
+	}
+	return nil
+}
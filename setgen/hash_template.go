@@ -0,0 +1,481 @@
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package main
+
+// hashTmp generates a set which compares the elements with their Hash and
+// Equal methods, it is used instead of tmp with -hash.
+const hashTmp = `// Code generated by SetGen[https://github.com/SeananXu/go-set#setgen]. DO NOT EDIT.
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+// Package set implements a set of elements compared by their Hash and Equal
+// methods.
+//
+// Reference: https://en.wikipedia.org/wiki/Set_%28abstract_data_type%29
+package {{.pkg}}
+
+import ({{if .light}}
+	"errors"{{end}}
+	"fmt"{{if .iter}}
+	"iter"{{end}}
+	"sort"
+	"strings"
+{{if .ipt}}{{if .light}}
+	"{{.ipt}}"
+){{else}}
+	"{{.ipt}}"
+
+	"github.com/SeananXu/go-set"
+){{end}}{{else}}{{if .light}}){{else}}
+	"github.com/SeananXu/go-set"
+){{end}}{{end}}{{if .light}}
+
+// ErrBreakEach breaks that the EachE traverses the elements in the set.
+var ErrBreakEach = errors.New("break each func"){{end}}
+
+// {{.st}} is a {{.tp}} collection that contains no duplicate elements, without any particular order.
+// The elements are compared with their Equal method instead of ==, and chained by their Hash
+// method, so {{.tp}} must have the methods:
+//
+//	Hash() uint64
+//	Equal(other {{.tp}}) bool
+//
+// Elements which are equal must have the same hash, or the set may hold duplicates.
+// The zero value is an empty {{.st}} ready to use.
+type {{.st}} struct {
+	// buckets maps a hash to the members which have it.
+	buckets map[uint64][]{{.tp}}
+	n       int
+}
+
+// New{{.st}} initializes a new {{.st}}.
+func New{{.st}}(elements ...{{.tp}}) *{{.st}} {
+	s := &{{.st}}{}
+	s.Add(elements...)
+	return s
+}
+
+// find returns the hash of element and the index of the member equal to it
+// in its bucket.
+func (s *{{.st}}) find(element {{.tp}}) (uint64, int, bool) {
+	key := element.Hash()
+	for i, member := range s.buckets[key] {
+		if member.Equal(element) {
+			return key, i, true
+		}
+	}
+	return key, 0, false
+}
+
+// Add adds the elements to {{.st}}, if it is not present already.
+func (s *{{.st}}) Add(elements ...{{.tp}}) {
+	for _, element := range elements {
+		key, _, ok := s.find(element)
+		if ok {
+			continue
+		}
+		if s.buckets == nil {
+			s.buckets = map[uint64][]{{.tp}}{}
+		}
+		s.buckets[key] = append(s.buckets[key], element)
+		s.n++
+	}
+}
+
+// Remove removes the element from {{.st}}, if it is present.
+func (s *{{.st}}) Remove(elements ...{{.tp}}) {
+	for _, element := range elements {
+		key, i, ok := s.find(element)
+		if !ok {
+			continue
+		}
+		bucket := s.buckets[key]
+		if len(bucket) == 1 {
+			delete(s.buckets, key)
+		} else {
+			var zero {{.tp}}
+			copy(bucket[i:], bucket[i+1:])
+			bucket[len(bucket)-1] = zero
+			s.buckets[key] = bucket[:len(bucket)-1]
+		}
+		s.n--
+	}
+}
+
+// Pop returns an arbitrary element of {{.st}}, deleting it from {{.st}}.
+// The second value is a bool that is true if the elements existed in
+// the {{.st}}, and false if not.
+func (s *{{.st}}) Pop() ({{.tp}}, bool) {
+	for _, bucket := range s.buckets {
+		element := bucket[0]
+		s.Remove(element)
+		return element, true
+	}
+	var zero {{.tp}}
+	return zero, false
+}
+
+// Size returns the number of elements in {{.st}}.
+func (s *{{.st}}) Size() int {
+	return s.n
+}
+
+// IsEmpty returns whether the {{.st}} is Empty.
+func (s *{{.st}}) IsEmpty() bool {
+	return s.n == 0
+}
+
+// Clear removes all items from the {{.st}}.
+func (s *{{.st}}) Clear() {
+	s.buckets = nil
+	s.n = 0
+}
+
+// Has judges the specified element whether exists in the {{.st}}.
+// it returns true if existed, and false if not.
+func (s *{{.st}}) Has(element {{.tp}}) bool {
+	_, _, ok := s.find(element)
+	return ok
+}
+
+// HasAll looks for the specified elements to judge
+// whether all exist in the {{.st}}.
+// it returns true if existed, and false if not.
+func (s *{{.st}}) HasAll(elements ...{{.tp}}) bool {
+	for _, element := range elements {
+		if !s.Has(element) {
+			return false
+		}
+	}
+	return true
+}
+
+// HasAny looks for the specified elements to judge
+// whether at least one of the element exists in the {{.st}}.
+// it returns true if existed, and false if not.
+func (s *{{.st}}) HasAny(elements ...{{.tp}}) bool {
+	for _, element := range elements {
+		if s.Has(element) {
+			return true
+		}
+	}
+	return false
+}
+
+// List returns the all elements as a slice.
+func (s *{{.st}}) List() []{{.tp}} {
+	dest := make([]{{.tp}}, 0, s.n)
+	for _, bucket := range s.buckets {
+		dest = append(dest, bucket...)
+	}
+	return dest
+}
+
+// SortedList returns the all elements as a slice sorted by less func.
+func (s *{{.st}}) SortedList(less func(i, j {{.tp}}) bool) []{{.tp}} {
+	dest := s.List()
+	sort.Slice(dest, func(i, j int) bool {
+		return less(dest[i], dest[j])
+	})
+	return dest
+}
+
+// EachE traverses the elements in the {{.st}}, calling do func for each
+// {{.st}} member. the cycle will be stopped when the do func returns error.
+// if err is ErrBreakEach, break the cycle and return nil,
+// else, break the cycle and return error.
+func (s *{{.st}}) EachE(do func(i {{.tp}}) error) error {
+	for _, bucket := range s.buckets {
+		for _, element := range bucket {
+			if err := do(element); err != nil {
+				if err == {{ if .light }}ErrBreakEach{{else}}set.ErrBreakEach{{end}} {
+					return nil
+				}
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Each traverses the elements in the {{.st}}, calling do func for each
+// {{.st}} member.
+func (s *{{.st}}) Each(do func(i {{.tp}})) {
+	for _, bucket := range s.buckets {
+		for _, element := range bucket {
+			do(element)
+		}
+	}
+}
+{{if .iter}}
+// All returns an iterator over the elements of {{.st}}, without any
+// particular order.
+func (s *{{.st}}) All() iter.Seq[{{.tp}}] {
+	return func(yield func({{.tp}}) bool) {
+		for _, bucket := range s.buckets {
+			for _, element := range bucket {
+				if !yield(element) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Collect{{.st}} initializes a new {{.st}} with the elements produced by seq.
+func Collect{{.st}}(seq iter.Seq[{{.tp}}]) *{{.st}} {
+	s := New{{.st}}()
+	for element := range seq {
+		s.Add(element)
+	}
+	return s
+}
+{{end}}
+// Union returns the union of {{.st}} s and t.
+// For example:
+// s = {a, b, c}
+// t = {a, c, d, e, f}
+// s.Union(t) = {a, b, c, d, e, f}
+func (s *{{.st}}) Union(t *{{.st}}) *{{.st}} {
+	u := s.Copy()
+	u.UnionWith(t)
+	return u
+}
+
+// Difference returns the difference of {{.st}} s and t.
+// For example:
+// s = {a, b, c}
+// t = {a, c, d, e, f}
+// s.Difference(t) = {b}
+func (s *{{.st}}) Difference(t *{{.st}}) *{{.st}} {
+	u := New{{.st}}()
+	s.Each(func(i {{.tp}}) {
+		if !t.Has(i) {
+			u.Add(i)
+		}
+	})
+	return u
+}
+
+// Intersection returns the intersection of {{.st}} s and t, the members of s
+// are kept.
+// For example:
+// s = {a, b, c}
+// t = {a, c, d, e, f}
+// s.Intersection(t) = {a, c}
+func (s *{{.st}}) Intersection(t *{{.st}}) *{{.st}} {
+	u := New{{.st}}()
+	s.Each(func(i {{.tp}}) {
+		if t.Has(i) {
+			u.Add(i)
+		}
+	})
+	return u
+}
+
+// SymmetricDifference returns a new {{.st}} with the elements that are either in this {{.st}}
+// or in the given {{.st}}, but not in both.
+// For example:
+// s = {a, c}
+// t = {a, b, d}
+// s.SymmetricDifference(t) = {c, b, d}
+func (s *{{.st}}) SymmetricDifference(t *{{.st}}) *{{.st}} {
+	u := s.Copy()
+	u.SymmetricDifferenceWith(t)
+	return u
+}
+
+// UnionWith adds all elements of {{.st}} t to {{.st}} s.
+func (s *{{.st}}) UnionWith(t *{{.st}}) {
+	if t == s {
+		return
+	}
+	t.Each(func(i {{.tp}}) {
+		s.Add(i)
+	})
+}
+
+// DifferenceWith removes all elements of {{.st}} t from {{.st}} s.
+func (s *{{.st}}) DifferenceWith(t *{{.st}}) {
+	// Remove shifts the bucket that Each ranges over, so s cannot be
+	// traversed while removing from itself.
+	if t == s {
+		s.Clear()
+		return
+	}
+	t.Each(func(i {{.tp}}) {
+		s.Remove(i)
+	})
+}
+
+// IntersectWith removes the elements of {{.st}} s which are not in {{.st}} t.
+func (s *{{.st}}) IntersectWith(t *{{.st}}) {
+	*s = *s.Intersection(t)
+}
+
+// SymmetricDifferenceWith keeps the elements that are either in {{.st}} s or in
+// {{.st}} t, but not in both.
+func (s *{{.st}}) SymmetricDifferenceWith(t *{{.st}}) {
+	if t == s {
+		s.Clear()
+		return
+	}
+	t.Each(func(i {{.tp}}) {
+		if s.Has(i) {
+			s.Remove(i)
+		} else {
+			s.Add(i)
+		}
+	})
+}
+
+// IsSubset predicates that tests whether the {{.st}} s is a subset of {{.st}} t.
+func (s *{{.st}}) IsSubset(t *{{.st}}) bool {
+	if s.n > t.n {
+		return false
+	}
+	for _, bucket := range s.buckets {
+		for _, element := range bucket {
+			if !t.Has(element) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// IsSuperset predicates that tests whether the {{.st}} s is a super of {{.st}} t.
+func (s *{{.st}}) IsSuperset(t *{{.st}}) bool {
+	return t.IsSubset(s)
+}
+
+// Equal predicates that tests whether the {{.st}} s equals of {{.st}} t.
+func (s *{{.st}}) Equal(t *{{.st}}) bool {
+	return s.n == t.n && s.IsSubset(t)
+}
+
+// Copy returns new {{.st}} that clones from {{.st}}.
+func (s *{{.st}}) Copy() *{{.st}} {
+	u := &{{.st}}{buckets: make(map[uint64][]{{.tp}}, len(s.buckets)), n: s.n}
+	for key, bucket := range s.buckets {
+		u.buckets[key] = append([]{{.tp}}(nil), bucket...)
+	}
+	return u
+}
+
+// String returns a string representation of {{.st}}, the elements are sorted
+// by their representation so that the output is the same between runs.
+func (s *{{.st}}) String() string {
+	return join{{.st}}(s.sorted(), "%v")
+}
+
+// Format implements fmt.Formatter:
+//   - %v formats the elements as String does, %+v adds the size of the set,
+//   - %#v formats a Go expression which creates the set,
+//   - %s formats the output of String with the flags of the verb,
+//   - the other verbs are applied to every element, as fmt does for slices.
+func (s *{{.st}}) Format(f fmt.State, verb rune) {
+	format{{.st}}(f, verb, s.sorted(), "New{{.st}}")
+}
+
+// sorted returns the elements sorted by their representation.
+func (s *{{.st}}) sorted() []{{.tp}} {
+	v := s.List()
+	keys := make([]string, len(v))
+	for i, element := range v {
+		keys[i] = fmt.Sprintf("%#v", element)
+	}
+	sort.Sort(&sorted{{.st}}{elements: v, keys: keys})
+	return v
+}
+
+// sorted{{.st}} sorts the elements by their keys.
+type sorted{{.st}} struct {
+	elements []{{.tp}}
+	keys     []string
+}
+
+func (s *sorted{{.st}}) Len() int {
+	return len(s.elements)
+}
+
+func (s *sorted{{.st}}) Less(i, j int) bool {
+	return s.keys[i] < s.keys[j]
+}
+
+func (s *sorted{{.st}}) Swap(i, j int) {
+	s.elements[i], s.elements[j] = s.elements[j], s.elements[i]
+	s.keys[i], s.keys[j] = s.keys[j], s.keys[i]
+}
+
+// join{{.st}} formats the elements with format, joined by ", " inside brackets.
+func join{{.st}}(elements []{{.tp}}, format string) string {
+	v := make([]string, len(elements))
+	for i, element := range elements {
+		v[i] = fmt.Sprintf(format, element)
+	}
+	return fmt.Sprintf("[%s]", strings.Join(v, ", "))
+}
+
+// format{{.st}} implements Format of {{.st}}, constructor is the name of the
+// function which creates the set.
+func format{{.st}}(f fmt.State, verb rune, elements []{{.tp}}, constructor string) {
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		v := make([]string, len(elements))
+		for i, element := range elements {
+			v[i] = fmt.Sprintf("%#v", element)
+		}
+		fmt.Fprintf(f, "{{.pkg}}.%s(%s)", constructor, strings.Join(v, ", "))
+	case verb == 's':
+		fmt.Fprintf(f, fmt.FormatString(f, verb), join{{.st}}(elements, "%v"))
+	case verb == 'v' && f.Flag('+'):
+		fmt.Fprintf(f, "%s (size: %d)", join{{.st}}(elements, fmt.FormatString(f, verb)), len(elements))
+	default:
+		fmt.Fprint(f, join{{.st}}(elements, fmt.FormatString(f, verb)))
+	}
+}
+`
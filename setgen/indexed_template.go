@@ -0,0 +1,467 @@
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package main
+
+// indexedTmp generates a set of values indexed by a key field, it is used
+// instead of tmp with -k.
+const indexedTmp = `// Code generated by SetGen[https://github.com/SeananXu/go-set#setgen]. DO NOT EDIT.
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+// Package set implements a set of values indexed by a key field.
+//
+// Reference: https://en.wikipedia.org/wiki/Set_%28abstract_data_type%29
+package {{.pkg}}
+
+import ({{if .light}}
+	"errors"{{end}}
+	"fmt"{{if .iter}}
+	"iter"{{end}}
+	"sort"
+	"strings"
+{{if .ipt}}{{if .light}}
+	"{{.ipt}}"
+){{else}}
+	"{{.ipt}}"
+
+	"github.com/SeananXu/go-set"
+){{end}}{{else}}{{if .light}}){{else}}
+	"github.com/SeananXu/go-set"
+){{end}}{{end}}{{if .light}}
+
+// ErrBreakEach breaks that the EachE traverses the elements in the set.
+var ErrBreakEach = errors.New("break each func"){{end}}
+
+// {{.st}} is a {{.tp}} collection that contains no two values with the same {{.key}},
+// without any particular order. The values are retained, and the set operations
+// compare the keys and carry the values of the left operand for the keys in both sets.
+type {{.st}} map[{{.kt}}]{{.tp}}
+
+// New{{.st}} initializes a new {{.st}}.
+func New{{.st}}(values ...{{.tp}}) {{.st}} {
+	s := {{.st}}{}
+	s.Add(values...)
+	return s
+}
+
+// New{{.st}}WithSize initializes a new {{.st}} with the specified size.
+func New{{.st}}WithSize(size int) {{.st}} {
+	return make(map[{{.kt}}]{{.tp}}, size)
+}
+
+// Add adds the values to {{.st}}, if no value with the same {{.key}} is present
+// already, the value present is kept.
+func (s {{.st}}) Add(values ...{{.tp}}) {
+	for _, value := range values {
+		if _, ok := s[value.{{.key}}]; !ok {
+			s[value.{{.key}}] = value
+		}
+	}
+}
+
+// Replace adds the values to {{.st}}, replacing the value with the same {{.key}}
+// if it is present.
+func (s {{.st}}) Replace(values ...{{.tp}}) {
+	for _, value := range values {
+		s[value.{{.key}}] = value
+	}
+}
+
+// Get returns the value with the {{.key}}. The second value is a bool that is
+// true if the value existed in the {{.st}}, and false if not.
+func (s {{.st}}) Get(key {{.kt}}) ({{.tp}}, bool) {
+	value, ok := s[key]
+	return value, ok
+}
+
+// Remove removes the values with the same {{.key}} as the values from {{.st}},
+// if it is present.
+func (s {{.st}}) Remove(values ...{{.tp}}) {
+	for _, value := range values {
+		delete(s, value.{{.key}})
+	}
+}
+
+// RemoveKey removes the values with the keys from {{.st}}, if it is present.
+func (s {{.st}}) RemoveKey(keys ...{{.kt}}) {
+	for _, key := range keys {
+		delete(s, key)
+	}
+}
+
+// Pop returns an arbitrary value of {{.st}}, deleting it from {{.st}}.
+// The second value is a bool that is true if the values existed in
+// the {{.st}}, and false if not.
+func (s {{.st}}) Pop() ({{.tp}}, bool) {
+	for k, value := range s {
+		delete(s, k)
+		return value, true
+	}
+	var zero {{.tp}}
+	return zero, false
+}
+
+// Size returns the number of values in {{.st}}.
+func (s {{.st}}) Size() int {
+	return len(s)
+}
+
+// IsEmpty returns whether the {{.st}} is Empty.
+func (s {{.st}}) IsEmpty() bool {
+	return len(s) == 0
+}
+
+// Clear removes all items from the {{.st}}.
+func (s *{{.st}}) Clear() {
+	*s = make(map[{{.kt}}]{{.tp}})
+}
+
+// Has judges whether a value with the same {{.key}} as the specified value
+// exists in the {{.st}}.
+// it returns true if existed, and false if not.
+func (s {{.st}}) Has(value {{.tp}}) bool {
+	_, ok := s[value.{{.key}}]
+	return ok
+}
+
+// HasKey judges the specified key whether exists in the {{.st}}.
+// it returns true if existed, and false if not.
+func (s {{.st}}) HasKey(key {{.kt}}) bool {
+	_, ok := s[key]
+	return ok
+}
+
+// HasAll looks for the keys of the specified values to judge
+// whether all exist in the {{.st}}.
+// it returns true if existed, and false if not.
+func (s {{.st}}) HasAll(values ...{{.tp}}) bool {
+	for _, value := range values {
+		if _, ok := s[value.{{.key}}]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// HasAny looks for the keys of the specified values to judge
+// whether at least one of the value exists in the {{.st}}.
+// it returns true if existed, and false if not.
+func (s {{.st}}) HasAny(values ...{{.tp}}) bool {
+	for _, value := range values {
+		if _, ok := s[value.{{.key}}]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Keys returns the keys of the values as a slice.
+func (s {{.st}}) Keys() []{{.kt}} {
+	dest := make([]{{.kt}}, 0, len(s))
+	for k := range s {
+		dest = append(dest, k)
+	}
+	return dest
+}
+
+// List returns the all values as a slice.
+func (s {{.st}}) List() []{{.tp}} {
+	dest := make([]{{.tp}}, 0, len(s))
+	for _, value := range s {
+		dest = append(dest, value)
+	}
+	return dest
+}
+
+// SortedList returns the all values as a slice sorted by less func.
+func (s {{.st}}) SortedList(less func(i, j {{.tp}}) bool) []{{.tp}} {
+	dest := s.List()
+	sort.Slice(dest, func(i, j int) bool {
+		return less(dest[i], dest[j])
+	})
+	return dest
+}
+
+// EachE traverses the values in the {{.st}}, calling do func for each
+// {{.st}} member. the cycle will be stopped when the do func returns error.
+// if err is ErrBreakEach, break the cycle and return nil,
+// else, break the cycle and return error.
+func (s {{.st}}) EachE(do func(i {{.tp}}) error) error {
+	for _, value := range s {
+		if err := do(value); err != nil {
+			if err == {{ if .light }}ErrBreakEach{{else}}set.ErrBreakEach{{end}} {
+				return nil
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// Each traverses the values in the {{.st}}, calling do func for each
+// {{.st}} member.
+func (s {{.st}}) Each(do func(i {{.tp}})) {
+	for _, value := range s {
+		do(value)
+	}
+}
+{{if .iter}}
+// All returns an iterator over the values of {{.st}}, without any
+// particular order.
+func (s {{.st}}) All() iter.Seq[{{.tp}}] {
+	return func(yield func({{.tp}}) bool) {
+		for _, value := range s {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// Collect{{.st}} initializes a new {{.st}} with the values produced by seq.
+func Collect{{.st}}(seq iter.Seq[{{.tp}}]) {{.st}} {
+	s := New{{.st}}()
+	for value := range seq {
+		s.Add(value)
+	}
+	return s
+}
+{{end}}
+// Union returns the union of {{.st}} s and t, the values of s are kept for
+// the keys in both.
+// For example:
+// s = {1: a, 2: b}
+// t = {2: x, 3: y}
+// s.Union(t) = {1: a, 2: b, 3: y}
+func (s {{.st}}) Union(t {{.st}}) {{.st}} {
+	u := s.Copy()
+	u.UnionWith(t)
+	return u
+}
+
+// Difference returns the values of {{.st}} s whose keys are not in {{.st}} t.
+// For example:
+// s = {1: a, 2: b}
+// t = {2: x, 3: y}
+// s.Difference(t) = {1: a}
+func (s {{.st}}) Difference(t {{.st}}) {{.st}} {
+	u := New{{.st}}()
+	for k, value := range s {
+		if _, ok := t[k]; !ok {
+			u[k] = value
+		}
+	}
+	return u
+}
+
+// Intersection returns the values of {{.st}} s whose keys are in {{.st}} t.
+// For example:
+// s = {1: a, 2: b}
+// t = {2: x, 3: y}
+// s.Intersection(t) = {2: b}
+func (s {{.st}}) Intersection(t {{.st}}) {{.st}} {
+	u := New{{.st}}()
+	for k, value := range s {
+		if _, ok := t[k]; ok {
+			u[k] = value
+		}
+	}
+	return u
+}
+
+// SymmetricDifference returns a new {{.st}} with the values whose keys are either
+// in this {{.st}} or in the given {{.st}}, but not in both.
+// For example:
+// s = {1: a, 2: b}
+// t = {2: x, 3: y}
+// s.SymmetricDifference(t) = {1: a, 3: y}
+func (s {{.st}}) SymmetricDifference(t {{.st}}) {{.st}} {
+	u := s.Copy()
+	u.SymmetricDifferenceWith(t)
+	return u
+}
+
+// UnionWith adds the values of {{.st}} t whose keys are not in {{.st}} s to s.
+func (s {{.st}}) UnionWith(t {{.st}}) {
+	for k, value := range t {
+		if _, ok := s[k]; !ok {
+			s[k] = value
+		}
+	}
+}
+
+// DifferenceWith removes the values whose keys are in {{.st}} t from {{.st}} s.
+func (s {{.st}}) DifferenceWith(t {{.st}}) {
+	for k := range t {
+		delete(s, k)
+	}
+}
+
+// IntersectWith removes the values of {{.st}} s whose keys are not in {{.st}} t.
+func (s {{.st}}) IntersectWith(t {{.st}}) {
+	for k := range s {
+		if _, ok := t[k]; !ok {
+			delete(s, k)
+		}
+	}
+}
+
+// SymmetricDifferenceWith keeps the values whose keys are either in {{.st}} s or
+// in {{.st}} t, but not in both.
+func (s {{.st}}) SymmetricDifferenceWith(t {{.st}}) {
+	for k, value := range t {
+		if _, ok := s[k]; ok {
+			delete(s, k)
+		} else {
+			s[k] = value
+		}
+	}
+}
+
+// IsSubset predicates that tests whether the keys of {{.st}} s are a subset of
+// the keys of {{.st}} t.
+func (s {{.st}}) IsSubset(t {{.st}}) bool {
+	if len(s) > len(t) {
+		return false
+	}
+	for k := range s {
+		if _, ok := t[k]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSuperset predicates that tests whether the keys of {{.st}} s are a superset
+// of the keys of {{.st}} t.
+func (s {{.st}}) IsSuperset(t {{.st}}) bool {
+	return t.IsSubset(s)
+}
+
+// Equal predicates that tests whether {{.st}} s and t have the same keys, the
+// values are not compared.
+func (s {{.st}}) Equal(t {{.st}}) bool {
+	return len(s) == len(t) && s.IsSubset(t)
+}
+
+// Copy returns new {{.st}} that clones from {{.st}}.
+func (s {{.st}}) Copy() {{.st}} {
+	t := New{{.st}}WithSize(len(s))
+	for k, value := range s {
+		t[k] = value
+	}
+	return t
+}
+
+// String returns a string representation of {{.st}}, the values are in the
+// order of sorted so that the output is the same between runs.
+func (s {{.st}}) String() string {
+	return join{{.st}}(s.sorted(), "%v")
+}
+
+// Format implements fmt.Formatter:
+//   - %v formats the values as String does, %+v adds the size of the set,
+//   - %#v formats a Go expression which creates the set,
+//   - %s formats the output of String with the flags of the verb,
+//   - the other verbs are applied to every value, as fmt does for slices.
+func (s {{.st}}) Format(f fmt.State, verb rune) {
+	if s == nil && verb == 'v' && f.Flag('#') {
+		fmt.Fprint(f, "{{.pkg}}.{{.st}}(nil)")
+		return
+	}
+	format{{.st}}(f, verb, s.sorted(), "New{{.st}}")
+}
+
+{{if .ordered}}// sorted returns the values in the natural order of their keys{{if .float}}, NaN first{{end}}.
+func (s {{.st}}) sorted() []{{.tp}} {
+	keys := s.Keys()
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i] < keys[j]{{if .float}} || keys[i] != keys[i] && keys[j] == keys[j]{{end}}
+	}){{else}}// sorted returns the values sorted by the representation of their keys.
+func (s {{.st}}) sorted() []{{.tp}} {
+	keys := s.Keys()
+	repr := make(map[{{.kt}}]string, len(keys))
+	for _, k := range keys {
+		repr[k] = fmt.Sprintf("%#v", k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return repr[keys[i]] < repr[keys[j]]
+	}){{end}}
+	v := make([]{{.tp}}, len(keys))
+	for i, k := range keys {
+		v[i] = s[k]
+	}
+	return v
+}
+
+// join{{.st}} formats the values with format, joined by ", " inside brackets.
+func join{{.st}}(values []{{.tp}}, format string) string {
+	v := make([]string, len(values))
+	for i, value := range values {
+		v[i] = fmt.Sprintf(format, value)
+	}
+	return fmt.Sprintf("[%s]", strings.Join(v, ", "))
+}
+
+// format{{.st}} implements Format of {{.st}}, constructor is the name of the
+// function which creates the set.
+func format{{.st}}(f fmt.State, verb rune, values []{{.tp}}, constructor string) {
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		v := make([]string, len(values))
+		for i, value := range values {
+			v[i] = fmt.Sprintf("%#v", value)
+		}
+		fmt.Fprintf(f, "{{.pkg}}.%s(%s)", constructor, strings.Join(v, ", "))
+	case verb == 's':
+		fmt.Fprintf(f, fmt.FormatString(f, verb), join{{.st}}(values, "%v"))
+	case verb == 'v' && f.Flag('+'):
+		fmt.Fprintf(f, "%s (size: %d)", join{{.st}}(values, fmt.FormatString(f, verb)), len(values))
+	default:
+		fmt.Fprint(f, join{{.st}}(values, fmt.FormatString(f, verb)))
+	}
+}
+`
@@ -0,0 +1,140 @@
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"strings"
+)
+
+// keyType returns the type of the field of the struct typeName which is
+// declared in the package of importPath, or in the current directory if
+// importPath is empty. The types and constants declared in the package are
+// qualified by the package name when the package is imported.
+func keyType(importPath, typeName, field string) (string, error) {
+	dir, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+	pkgName := ""
+	if importPath != "" {
+		p, err := build.Import(importPath, dir, build.FindOnly)
+		if err != nil {
+			return "", fmt.Errorf("find package %s: %v", importPath, err)
+		}
+		dir = p.Dir
+		pkgName = importPath[strings.LastIndex(importPath, "/")+1:]
+	}
+	pkgs, err := parser.ParseDir(token.NewFileSet(), dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return "", err
+	}
+	for _, p := range pkgs {
+		var st *ast.StructType
+		decls := map[string]bool{}
+		for _, f := range p.Files {
+			for _, decl := range f.Decls {
+				d, ok := decl.(*ast.GenDecl)
+				if !ok || (d.Tok != token.TYPE && d.Tok != token.CONST) {
+					continue
+				}
+				for _, spec := range d.Specs {
+					if vs, ok := spec.(*ast.ValueSpec); ok {
+						for _, name := range vs.Names {
+							decls[name.Name] = true
+						}
+						continue
+					}
+					ts := spec.(*ast.TypeSpec)
+					decls[ts.Name.Name] = true
+					if ts.Name.Name != typeName {
+						continue
+					}
+					if st, ok = ts.Type.(*ast.StructType); !ok {
+						return "", fmt.Errorf("%s is not a struct", typeName)
+					}
+				}
+			}
+		}
+		if st != nil {
+			return fieldType(st, typeName, field, pkgName, decls)
+		}
+	}
+	return "", fmt.Errorf("type %s is not declared in %s", typeName, dir)
+}
+
+// fieldType returns the type of the field of the struct st. When the package
+// is imported as pkgName, the field must be exported and the identifiers of
+// decls, the types and constants of the package, are qualified in its type,
+// such as [2]ID which becomes [2]model.ID.
+func fieldType(st *ast.StructType, typeName, field, pkgName string, decls map[string]bool) (string, error) {
+	for _, f := range st.Fields.List {
+		for _, name := range f.Names {
+			if name.Name != field {
+				continue
+			}
+			if pkgName != "" && !ast.IsExported(field) {
+				return "", fmt.Errorf("key %s.%s is unexported, it cannot be used out of package %s", typeName, field, pkgName)
+			}
+			// the names of the fields and parameters in the type are not
+			// identifiers of the package
+			names := map[*ast.Ident]bool{}
+			var err error
+			ast.Inspect(f.Type, func(n ast.Node) bool {
+				switch n := n.(type) {
+				case *ast.SelectorExpr:
+					err = fmt.Errorf("key %s.%s has type %s of another package, which is not supported", typeName, field, types.ExprString(f.Type))
+				case *ast.Field:
+					for _, name := range n.Names {
+						names[name] = true
+					}
+				case *ast.Ident:
+					if pkgName == "" || names[n] || !decls[n.Name] {
+						break
+					}
+					if !ast.IsExported(n.Name) {
+						err = fmt.Errorf("key %s.%s has type %s, which refers to %s unexported by package %s", typeName, field, types.ExprString(f.Type), n.Name, pkgName)
+						break
+					}
+					n.Name = pkgName + "." + n.Name
+				}
+				return err == nil
+			})
+			if err != nil {
+				return "", err
+			}
+			return types.ExprString(f.Type), nil
+		}
+	}
+	return "", fmt.Errorf("%s has no field %s", typeName, field)
+}
@@ -25,90 +25,289 @@ SOFTWARE.
 package main
 
 import (
+	"bytes"
+	"errors"
 	"flag"
+	"fmt"
+	"go/format"
 	"io"
 	"log"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"text/template"
 )
 
-var (
-	st     = flag.String("s", "", "Set name, default: element type add 's'.")
-	ipt    = flag.String("i", "", "Import element package, default: don't import package.")
-	pkg    = flag.String("p", "", "Generated go file package, default: directory name.")
-	tp     = flag.String("t", "", "Set storage element type, this options must be set.")
-	output = flag.String("o", "", "Output file name, default: set name add '.go'.")
-	light  = flag.Bool("l", false, "Whether go file imports 'ErrBreakEach' of 'github.com/SeananXu/go-set', default: import.")
-)
-
 func main() {
-	flag.Parse()
-	if *tp == "" {
-		log.Fatalf("empty element type, please use -t set up the type")
-	}
-	if strings.HasPrefix(*tp, "**") {
-		log.Fatalf("element type invalid, the prefix only allows one '*'")
-	}
-	structName := *tp
-	if strings.HasPrefix(*tp, "*") {
-		structName = (*tp)[1:]
-	}
-	if *st == "" {
-		*st = structName + "s"
-	}
-	pkgWithStruct := structName
-	if *ipt != "" {
-		if i := strings.LastIndex(*ipt, "/"); i != -1 {
-			pkgWithStruct = (*ipt)[i+1:] + "." + structName
-		} else {
-			pkgWithStruct = *ipt + "." + structName
+	if err := run(os.Args[1:], os.Stdout); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			os.Exit(0)
+		}
+		log.Fatal(err)
+	}
+}
+
+// listFlag is a flag which may be repeated or hold comma-separated values,
+// such as -t A,B -t C.
+type listFlag []string
+
+func (l *listFlag) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *listFlag) Set(value string) error {
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			*l = append(*l, v)
 		}
 	}
-	var obj string
-	if strings.HasPrefix(*tp, "*") {
-		obj += "&" + pkgWithStruct + "{}"
-		*tp = "*" + pkgWithStruct
-	} else {
-		*tp = pkgWithStruct
-		obj = pkgWithStruct + "{}"
+	return nil
+}
+
+// options are the flags shared by the sets generated in one run.
+type options struct {
+	pkg   string
+	light bool
+	sync  bool
+	hash  bool
+	key   string
+	iter  bool
+}
+
+// run generates the sets described by the command line arguments args, the
+// go file is written to stdout with -o -. The sets of several types are
+// generated into a single go file, which must type-check with the package
+// it is generated into.
+func run(args []string, stdout io.Writer) error {
+	var types, names, imports listFlag
+	flags := flag.NewFlagSet("setgen", flag.ContinueOnError)
+	flags.Var(&names, "s", "Set names in the order of the element types, default: element type add 's'.")
+	flags.Var(&imports, "i", "Import element packages, a type qualified as pkg.T is imported from the path ending with pkg, default: don't import package.")
+	pkg := flags.String("p", "", "Generated go file package, default: $GOPACKAGE set by go generate, or directory name.")
+	flags.Var(&types, "t", "Set storage element types, comma-separated or repeated, such as A,*B,pkg.C, this options must be set.")
+	output := flags.String("o", "", "Output file name, default: set name add '.go' for one type, $GOFILE base name add '_sets.go' or 'sets.go' for several types.")
+	light := flags.Bool("l", false, "Whether go file imports 'ErrBreakEach' of 'github.com/SeananXu/go-set', default: import.")
+	sync := flags.Bool("sync", false, "Whether to generate the concurrency-safe 'Sync' + set name as well, default: don't generate.")
+	hash := flags.Bool("hash", false, "Whether the set compares elements with their 'Hash() uint64' and 'Equal(other) bool' methods instead of '==', default: compare with '=='.")
+	key := flags.String("k", "", "Key field of the element struct, the set holds one element per key and retains it, default: the set holds the elements.")
+	goVer := flags.String("go", "", "Go version targeted by the go file, such as 1.23, the 'iter.Seq' iterators are generated from 1.23, default: don't generate.")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if len(types) == 0 {
+		return errors.New("empty element type, please use -t set up the type")
+	}
+	if len(names) != 0 && len(names) != len(types) {
+		return fmt.Errorf("%d set names for %d element types, please set one name per type or none", len(names), len(types))
+	}
+	if *hash && *sync {
+		return errors.New("-sync is not supported with -hash")
+	}
+	if *key != "" && (*hash || *sync) {
+		return errors.New("-hash and -sync are not supported with -k")
+	}
+	iterators, err := supportsIterators(*goVer)
+	if err != nil {
+		return fmt.Errorf("go version invalid: %v", err)
+	}
+	if *pkg == "" {
+		*pkg = os.Getenv("GOPACKAGE")
 	}
 	if *pkg == "" {
 		pwd, _ := os.Getwd()
 		*pkg = filepath.Base(pwd)
 	}
-	t, err := template.New("setgen").Parse(tmp)
-	if err != nil {
-		log.Fatalf("parse template file error: %v", err)
+	opts := options{pkg: *pkg, light: *light, sync: *sync, hash: *hash, key: *key, iter: iterators}
+	qualified := false
+	for _, tp := range types {
+		qualified = qualified || strings.Contains(tp, ".")
 	}
-	var writer io.Writer
-	switch *output {
-	case "":
-		f, err := os.OpenFile(strings.ToLower(*st)+".go", os.O_RDWR|os.O_TRUNC|os.O_CREATE, 0644)
+	files := make([][]byte, len(types))
+	seen := map[string]bool{}
+	var st string
+	for i, tp := range types {
+		elem, err := resolveType(tp, imports, qualified)
 		if err != nil {
-			log.Fatalf("create writer steam error: %v", err)
+			return err
+		}
+		st = elem.name + "s"
+		if len(names) != 0 {
+			st = names[i]
+		}
+		if seen[st] {
+			return fmt.Errorf("duplicate set name %s, please use -s to name the sets", st)
+		}
+		seen[st] = true
+		if files[i], err = generate(st, elem, opts); err != nil {
+			return err
 		}
-		defer f.Close()
-		writer = f
-	case "-":
-		writer = os.Stdout
+	}
+	src, err := combine(files)
+	if err != nil {
+		return err
+	}
+	// the go file written to stdout is checked with the package in the
+	// current directory
+	name := *output
+	switch {
+	case name == "-":
+		name = "stdout.go"
+	case name != "":
+	case len(types) == 1:
+		name = strings.ToLower(st) + ".go"
+	case os.Getenv("GOFILE") != "":
+		name = strings.TrimSuffix(os.Getenv("GOFILE"), ".go") + "_sets.go"
 	default:
-		f, err := os.OpenFile(*output, os.O_RDWR|os.O_TRUNC|os.O_CREATE, 0644)
-		if err != nil {
-			log.Fatalf("create writer steam error: %v", err)
+		name = "sets.go"
+	}
+	if err := typeCheck(name, src); err != nil {
+		return fmt.Errorf("generated go file of %s does not type-check, please check the flags: %v", strings.Join(types, ","), err)
+	}
+	if *output == "-" {
+		_, err = stdout.Write(src)
+		return err
+	}
+	return writeFile(name, src)
+}
+
+// element is an element type given with -t.
+type element struct {
+	// typ is the element type in the generated go file, such as *model.User.
+	typ string
+	// name is the name of the type without the pointer and the package.
+	name string
+	// importPath is the path of the package which declares the type, it is
+	// empty for the types of the generated package.
+	importPath string
+}
+
+// resolveType resolves the element type tp given with -t. A type qualified
+// as pkg.T is imported from the path of imports ending with pkg, an
+// unqualified type is imported from the single path of imports when none of
+// the types is qualified, as setgen did before it accepted several types.
+func resolveType(tp string, imports []string, qualified bool) (element, error) {
+	if strings.HasPrefix(tp, "**") {
+		return element{}, fmt.Errorf("element type %s invalid, the prefix only allows one '*'", tp)
+	}
+	pointer := strings.HasPrefix(tp, "*")
+	name := strings.TrimPrefix(tp, "*")
+	var e element
+	switch i := strings.LastIndex(name, "."); {
+	case i != -1:
+		pkgName := name[:i]
+		for _, path := range imports {
+			if path[strings.LastIndex(path, "/")+1:] == pkgName {
+				e.importPath = path
+			}
+		}
+		if e.importPath == "" {
+			return element{}, fmt.Errorf("element type %s invalid, please use -i import the package %s", tp, pkgName)
 		}
-		defer f.Close()
-		writer = f
-	}
-	if err = t.Execute(writer, map[string]interface{}{
-		"st":    st,
-		"tp":    tp,
-		"obj":   obj,
-		"light": *light,
-		"ipt":   *ipt,
-		"pkg":   *pkg,
+		e.typ, e.name = name, name[i+1:]
+	case len(imports) == 1 && !qualified:
+		e.importPath = imports[0]
+		e.typ, e.name = imports[0][strings.LastIndex(imports[0], "/")+1:]+"."+name, name
+	default:
+		e.typ, e.name = name, name
+	}
+	if pointer {
+		e.typ = "*" + e.typ
+	}
+	return e, nil
+}
+
+// generate returns the formatted go file of the set named st of the element
+// type elem.
+func generate(st string, elem element, opts options) ([]byte, error) {
+	var kt string
+	if opts.key != "" {
+		var err error
+		if kt, err = keyType(elem.importPath, elem.name, opts.key); err != nil {
+			return nil, fmt.Errorf("key field invalid: %v", err)
+		}
+	}
+	// the elements of the indexed sets are sorted by their keys
+	ordered, float := orderedType(elem.typ)
+	if opts.key != "" {
+		ordered, float = orderedType(kt)
+	}
+	text := tmp
+	switch {
+	case opts.hash:
+		text = hashTmp
+	case opts.key != "":
+		text = indexedTmp
+	}
+	t, err := template.New("setgen").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("parse template file error: %v", err)
+	}
+	var buf bytes.Buffer
+	if err = t.Execute(&buf, map[string]interface{}{
+		"st":      st,
+		"tp":      elem.typ,
+		"ordered": ordered,
+		"float":   float,
+		"light":   opts.light,
+		"sync":    opts.sync,
+		"iter":    opts.iter,
+		"key":     opts.key,
+		"kt":      kt,
+		"ipt":     elem.importPath,
+		"pkg":     opts.pkg,
 	}); err != nil {
-		log.Fatalf("excute output file error: %v", err)
+		return nil, fmt.Errorf("excute output file error: %v", err)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("generated go file of %s is invalid, please check the flags: %v", elem.typ, err)
+	}
+	return src, nil
+}
+
+// orderedType reports whether typ is a predeclared type which supports the
+// < operator, and whether it is a float, whose NaNs are sorted first.
+func orderedType(typ string) (ordered, float bool) {
+	switch typ {
+	case "float32", "float64":
+		return true, true
+	case "string", "int", "int8", "int16", "int32", "int64", "rune", "byte",
+		"uint", "uint8", "uint16", "uint32", "uint64", "uintptr":
+		return true, false
+	}
+	return false, false
+}
+
+// writeFile writes src to the file name, unless the file holds src already
+// so that its modification time is kept, as go generate is run repeatedly.
+func writeFile(name string, src []byte) error {
+	if old, err := os.ReadFile(name); err == nil && bytes.Equal(old, src) {
+		return nil
+	}
+	if err := os.WriteFile(name, src, 0644); err != nil {
+		return fmt.Errorf("write output file error: %v", err)
+	}
+	return nil
+}
+
+// supportsIterators reports whether the Go version, such as 1.23 or go1.23.1,
+// supports range over func iterators. An empty version is assumed not to.
+func supportsIterators(version string) (bool, error) {
+	if version == "" {
+		return false, nil
+	}
+	parts := strings.Split(strings.TrimPrefix(version, "go"), ".")
+	if len(parts) < 2 {
+		return false, fmt.Errorf("expect a version such as 1.23, but got: %q", version)
+	}
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return false, fmt.Errorf("expect a version such as 1.23, but got: %q", version)
+	}
+	minor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return false, fmt.Errorf("expect a version such as 1.23, but got: %q", version)
 	}
+	return major > 1 || major == 1 && minor >= 23, nil
 }
@@ -0,0 +1,219 @@
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"go/build"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+func TestRun_Golden(t *testing.T) {
+	testcases := []struct {
+		name string
+		args []string
+	}{
+		{name: "default", args: []string{"-t", "Example"}},
+		{name: "light", args: []string{"-t", "Example", "-l"}},
+		{name: "import", args: []string{"-t", "User", "-i", "github.com/SeananXu/go-set/setgen/testdata/model"}},
+		{name: "import_light", args: []string{"-t", "User", "-i", "github.com/SeananXu/go-set/setgen/testdata/model", "-l"}},
+		{name: "basic", args: []string{"-t", "int", "-s", "Ints", "-sync"}},
+		{name: "basic_float", args: []string{"-t", "float64", "-s", "Float64s"}},
+		{name: "pointer", args: []string{"-t", "*Example"}},
+		{name: "pointer_light", args: []string{"-t", "*Example", "-l"}},
+		{name: "pointer_import", args: []string{"-t", "*User", "-i", "github.com/SeananXu/go-set/setgen/testdata/model", "-s", "UserSet"}},
+		{name: "sync", args: []string{"-t", "Example", "-sync"}},
+		{name: "sync_light", args: []string{"-t", "Example", "-sync", "-l"}},
+		{name: "iter", args: []string{"-t", "Example", "-sync", "-go", "1.23"}},
+		{name: "hash", args: []string{"-t", "Example", "-hash"}},
+		{name: "hash_light", args: []string{"-t", "*Node", "-hash", "-l", "-go", "1.23"}},
+		{name: "key", args: []string{"-t", "User", "-k", "ID"}},
+		{name: "key_light", args: []string{"-t", "*User", "-k", "ID", "-l"}},
+		{name: "key_import", args: []string{"-t", "model.User", "-i", "github.com/SeananXu/go-set/setgen/testdata/model", "-k", "Refs"}},
+		{name: "multi", args: []string{"-t", "Example,*model.Account", "-t", "User", "-i", "github.com/SeananXu/go-set/setgen/testdata/model", "-l", "-sync"}},
+		{name: "multi_names", args: []string{"-t", "Example,User", "-s", "ExampleSet,UserSet", "-hash"}},
+	}
+	// -k reads the element struct from the current directory
+	// the go files with iterators import package iter, which older Go does
+	// not have to type-check them
+	iterators := false
+	for _, tag := range build.Default.ReleaseTags {
+		iterators = iterators || tag == "go1.23"
+	}
+	chdir(t, "testdata")
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		if strings.Contains(strings.Join(tc.args, " "), "-go 1.23") && !iterators {
+			t.Logf("skip scenario %s before go1.23", tc.name)
+			continue
+		}
+		var out bytes.Buffer
+		if err := run(append(tc.args, "-p", "gen", "-o", "-"), &out); err != nil {
+			t.Errorf("expect no error, but got: %v", err)
+			continue
+		}
+		golden := tc.name + ".golden"
+		if *update {
+			if err := os.WriteFile(golden, out.Bytes(), 0644); err != nil {
+				t.Fatalf("expect no error, but got: %v", err)
+			}
+			continue
+		}
+		expect, err := os.ReadFile(golden)
+		if err != nil {
+			t.Fatalf("expect no error, but got: %v", err)
+		}
+		if !bytes.Equal(out.Bytes(), expect) {
+			t.Errorf("expect the output equals %s, run go test -update to regenerate it if the change is intended", golden)
+		}
+		// run type-checks its output, the golden files are checked as well
+		// in case they are stale
+		if err := typeCheck(golden+".go", expect); err != nil {
+			t.Errorf("expect %s to type-check, but got: %v", golden, err)
+		}
+	}
+}
+
+func TestRun_Output(t *testing.T) {
+	expect, err := os.ReadFile(filepath.Join("testdata", "default.golden"))
+	if err != nil {
+		t.Fatalf("expect no error, but got: %v", err)
+	}
+	testcases := []struct {
+		args []string
+		name string
+	}{
+		{args: []string{"-t", "Example", "-p", "gen"}, name: "examples.go"},
+		{args: []string{"-t", "Example", "-p", "gen", "-o", "custom.go"}, name: "custom.go"},
+	}
+	for _, tc := range testcases {
+		// the files are generated apart, as two files of the same sets
+		// would not type-check in one package
+		chdir(t, t.TempDir())
+		if err := run(tc.args, nil); err != nil {
+			t.Fatalf("expect no error, but got: %v", err)
+		}
+		got, err := os.ReadFile(tc.name)
+		if err != nil {
+			t.Fatalf("expect no error, but got: %v", err)
+		}
+		if !bytes.Equal(got, expect) {
+			t.Errorf("expect %s equals default.golden", tc.name)
+		}
+	}
+}
+
+func TestRun_GoGenerate(t *testing.T) {
+	chdir(t, t.TempDir())
+	t.Setenv("GOFILE", "types.go")
+	t.Setenv("GOPACKAGE", "models")
+	if err := run([]string{"-t", "A,B"}, nil); err != nil {
+		t.Fatalf("expect no error, but got: %v", err)
+	}
+	src, err := os.ReadFile("types_sets.go")
+	if err != nil {
+		t.Fatalf("expect no error, but got: %v", err)
+	}
+	if !bytes.Contains(src, []byte("\npackage models\n")) {
+		t.Errorf("expect the package of $GOPACKAGE: models")
+	}
+
+	// the file is not written again when it is unchanged
+	old := time.Now().Add(-time.Hour).Truncate(time.Second)
+	if err := os.Chtimes("types_sets.go", old, old); err != nil {
+		t.Fatalf("expect no error, but got: %v", err)
+	}
+	if err := run([]string{"-t", "A,B"}, nil); err != nil {
+		t.Fatalf("expect no error, but got: %v", err)
+	}
+	info, err := os.Stat("types_sets.go")
+	if err != nil {
+		t.Fatalf("expect no error, but got: %v", err)
+	}
+	if !info.ModTime().Equal(old) {
+		t.Errorf("expect modification time: %v, but got: %v", old, info.ModTime())
+	}
+	if err := run([]string{"-t", "A,C"}, nil); err != nil {
+		t.Fatalf("expect no error, but got: %v", err)
+	}
+	if info, _ = os.Stat("types_sets.go"); info.ModTime().Equal(old) {
+		t.Errorf("expect the changed file written")
+	}
+}
+
+func TestRun_Errors(t *testing.T) {
+	testcases := []struct {
+		name   string
+		args   []string
+		expect string
+	}{
+		{name: "no type", args: nil, expect: "empty element type"},
+		{name: "double pointer", args: []string{"-t", "**Example"}, expect: "only allows one '*'"},
+		{name: "hash and sync", args: []string{"-t", "Example", "-hash", "-sync"}, expect: "not supported"},
+		{name: "invalid version", args: []string{"-t", "Example", "-go", "1"}, expect: "go version invalid"},
+		{name: "invalid output", args: []string{"-t", "Bad Type"}, expect: "generated go file of Bad Type is invalid"},
+		{name: "undeclared type", args: []string{"-t", "Example,Missing"}, expect: "generated go file of Example,Missing does not type-check"},
+		{name: "type without hash", args: []string{"-t", "model.A", "-i", "github.com/SeananXu/go-set/setgen/testdata/model", "-hash"}, expect: "type model.A has no field or method Hash"},
+		{name: "unknown key", args: []string{"-t", "User", "-k", "Email"}, expect: "User has no field Email"},
+		{name: "unexported imported key", args: []string{"-t", "model.User", "-i", "github.com/SeananXu/go-set/setgen/testdata/model", "-k", "id"}, expect: "key User.id is unexported"},
+		{name: "key of unexported type", args: []string{"-t", "model.User", "-i", "github.com/SeananXu/go-set/setgen/testdata/model", "-k", "Tags"}, expect: "refers to refs unexported by package model"},
+		{name: "set names mismatch", args: []string{"-t", "A,B", "-s", "As"}, expect: "1 set names for 2 element types"},
+		{name: "duplicate set names", args: []string{"-t", "A,model.A", "-i", "github.com/SeananXu/go-set/setgen/testdata/model"}, expect: "duplicate set name As"},
+		{name: "qualified type not imported", args: []string{"-t", "model.A"}, expect: "please use -i import the package model"},
+	}
+	chdir(t, "testdata")
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		var out bytes.Buffer
+		err := run(append(tc.args, "-p", "gen", "-o", "-"), &out)
+		if err == nil || !strings.Contains(err.Error(), tc.expect) {
+			t.Errorf("expect error: %s, but got: %v", tc.expect, err)
+		}
+		if out.Len() != 0 {
+			t.Errorf("expect no output on error, but got %d bytes", out.Len())
+		}
+	}
+}
+
+// chdir changes the working directory to dir until the test ends.
+func chdir(t *testing.T, dir string) {
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("expect no error, but got: %v", err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatalf("expect no error, but got: %v", err)
+	}
+	t.Cleanup(func() {
+		os.Chdir(wd)
+	})
+}
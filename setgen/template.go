@@ -55,9 +55,14 @@ SOFTWARE.
 package {{.pkg}}
 
 import (
-	"fmt"
+	"bytes"
+	"encoding/json"{{if .light}}
+	"errors"{{end}}
+	"fmt"{{if .iter}}
+	"iter"{{end}}
 	"sort"
-	"strings"
+	"strings"{{if .sync}}
+	"sync"{{end}}
 {{if .ipt}}{{if .light}}
 	"{{.ipt}}"
 ){{else}}
@@ -110,7 +115,8 @@ func (s {{.st}}) Pop() ({{.tp}}, bool) {
 		delete(s, k)
 		return k, true
 	}
-	return {{.obj}}, false
+	var zero {{.tp}}
+	return zero, false
 }
 
 // Size returns the number of elements in {{.st}}.
@@ -200,6 +206,111 @@ func (s {{.st}}) Each(do func(i {{.tp}})) {
 		do(k)
 	}
 }
+{{if .iter}}
+// All returns an iterator over the elements of {{.st}}, without any
+// particular order.
+func (s {{.st}}) All() iter.Seq[{{.tp}}] {
+	return func(yield func({{.tp}}) bool) {
+		for k := range s {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Sorted returns an iterator over the elements of {{.st}} in the order of
+// String, it iterates a sorted snapshot taken when the iteration starts.
+func (s {{.st}}) Sorted() iter.Seq[{{.tp}}] {
+	return func(yield func({{.tp}}) bool) {
+		for _, element := range s.sorted() {
+			if !yield(element) {
+				return
+			}
+		}
+	}
+}
+
+// Collect{{.st}} initializes a new {{.st}} with the elements produced by seq.
+func Collect{{.st}}(seq iter.Seq[{{.tp}}]) {{.st}} {
+	s := New{{.st}}()
+	for element := range seq {
+		s[element] = struct{}{}
+	}
+	return s
+}
+{{end}}
+// Filter returns a new {{.st}} with the elements of {{.st}} s for which pred
+// returns true.
+func (s {{.st}}) Filter(pred func(i {{.tp}}) bool) {{.st}} {
+	u := New{{.st}}()
+	for k := range s {
+		if pred(k) {
+			u[k] = struct{}{}
+		}
+	}
+	return u
+}
+
+// Partition returns two new sets, the first with the elements of {{.st}} s
+// for which pred returns true, the second with the others.
+func (s {{.st}}) Partition(pred func(i {{.tp}}) bool) ({{.st}}, {{.st}}) {
+	in, out := New{{.st}}(), New{{.st}}()
+	for k := range s {
+		if pred(k) {
+			in[k] = struct{}{}
+		} else {
+			out[k] = struct{}{}
+		}
+	}
+	return in, out
+}
+
+// Any reports whether pred returns true for any element of {{.st}} s, the
+// traversal stops at the first such element.
+func (s {{.st}}) Any(pred func(i {{.tp}}) bool) bool {
+	for k := range s {
+		if pred(k) {
+			return true
+		}
+	}
+	return false
+}
+
+// Every reports whether pred returns true for every element of {{.st}} s,
+// the traversal stops at the first element for which pred returns false.
+func (s {{.st}}) Every(pred func(i {{.tp}}) bool) bool {
+	for k := range s {
+		if !pred(k) {
+			return false
+		}
+	}
+	return true
+}
+
+// Find returns an arbitrary element of {{.st}} s for which pred returns
+// true. The second value is false if there is no such element.
+func (s {{.st}}) Find(pred func(i {{.tp}}) bool) ({{.tp}}, bool) {
+	for k := range s {
+		if pred(k) {
+			return k, true
+		}
+	}
+	var zero {{.tp}}
+	return zero, false
+}
+
+// Count returns the number of elements of {{.st}} s for which pred returns
+// true.
+func (s {{.st}}) Count(pred func(i {{.tp}}) bool) int {
+	n := 0
+	for k := range s {
+		if pred(k) {
+			n++
+		}
+	}
+	return n
+}
 
 // Union returns the union of {{.st}} s and t.
 // For example:
@@ -280,7 +391,74 @@ func (s {{.st}}) Intersection(t {{.st}}) {{.st}} {
 // s.SymmetricDifference(s) = {c, b, d}
 // s.SymmetricDifference(s) = s.SymmetricDifference(s)
 func (s {{.st}}) SymmetricDifference(t {{.st}}) {{.st}} {
-	return s.Difference(t).Union(t.Difference(s))
+	u := s.Copy()
+	u.SymmetricDifferenceWith(t)
+	return u
+}
+
+// UnionWith adds all elements of {{.st}} t to {{.st}} s, it is the in-place
+// version of Union which reuses s instead of allocating a new {{.st}}.
+// For example:
+// s = {a, b, c}
+// t = {a, c, d}
+// s.UnionWith(t), s = {a, b, c, d}
+func (s {{.st}}) UnionWith(t {{.st}}) {
+	for k := range t {
+		s[k] = struct{}{}
+	}
+}
+
+// DifferenceWith removes all elements of {{.st}} t from {{.st}} s, it is the in-place
+// version of Difference which reuses s instead of allocating a new {{.st}}.
+// For example:
+// s = {a, b, c}
+// t = {a, c, d}
+// s.DifferenceWith(t), s = {b}
+func (s {{.st}}) DifferenceWith(t {{.st}}) {
+	// iterate the smaller one, deleting an absent key is a no-op
+	if len(s) < len(t) {
+		for k := range s {
+			if t.Has(k) {
+				delete(s, k)
+			}
+		}
+		return
+	}
+	for k := range t {
+		delete(s, k)
+	}
+}
+
+// IntersectWith removes the elements of {{.st}} s which are not in {{.st}} t, it is
+// the in-place version of Intersection which reuses s instead of allocating
+// a new {{.st}}.
+// For example:
+// s = {a, b, c}
+// t = {a, c, d}
+// s.IntersectWith(t), s = {a, c}
+func (s {{.st}}) IntersectWith(t {{.st}}) {
+	for k := range s {
+		if !t.Has(k) {
+			delete(s, k)
+		}
+	}
+}
+
+// SymmetricDifferenceWith keeps the elements that are either in {{.st}} s or in
+// {{.st}} t, but not in both, it is the in-place version of SymmetricDifference
+// which reuses s instead of allocating a new {{.st}}.
+// For example:
+// s = {a, c}
+// t = {a, b, d}
+// s.SymmetricDifferenceWith(t), s = {c, b, d}
+func (s {{.st}}) SymmetricDifferenceWith(t {{.st}}) {
+	for k := range t {
+		if s.Has(k) {
+			delete(s, k)
+		} else {
+			s[k] = struct{}{}
+		}
+	}
 }
 
 // IsSubset predicates that tests whether the {{.st}} s is a subset of {{.st}} t.
@@ -333,12 +511,581 @@ func (s {{.st}}) Copy() {{.st}} {
 	return t
 }
 
-// String returns a string representation of {{.st}}
+// String returns a string representation of {{.st}}, the elements are in the
+// order of sorted so that the output is the same between runs.
 func (s {{.st}}) String() string {
-	v := make([]string, 0, s.Size())
-	for element := range s {
-		v = append(v, fmt.Sprintf("%v", element))
+	return join{{.st}}(s.sorted(), "%v")
+}
+
+// Format implements fmt.Formatter:
+//   - %v formats the elements as String does, %+v adds the size of the set,
+//   - %#v formats a Go expression which creates the set,
+//   - %s formats the output of String with the flags of the verb,
+//   - the other verbs are applied to every element, as fmt does for slices.
+func (s {{.st}}) Format(f fmt.State, verb rune) {
+	if s == nil && verb == 'v' && f.Flag('#') {
+		fmt.Fprint(f, "{{.pkg}}.{{.st}}(nil)")
+		return
+	}
+	format{{.st}}(f, verb, s.sorted(), "New{{.st}}")
+}
+
+{{if .ordered}}// sorted returns the elements in their natural order{{if .float}}, NaN first{{end}}.
+func (s {{.st}}) sorted() []{{.tp}} {
+	v := s.List()
+	sort.Slice(v, func(i, j int) bool {
+		return v[i] < v[j]{{if .float}} || v[i] != v[i] && v[j] == v[j]{{end}}
+	})
+	return v
+}
+{{else}}// sorted returns the elements sorted by their representation.
+func (s {{.st}}) sorted() []{{.tp}} {
+	v := s.List()
+	keys := make(map[{{.tp}}]string, len(v))
+	for _, element := range v {
+		keys[element] = fmt.Sprintf("%#v", element)
+	}
+	sort.Slice(v, func(i, j int) bool {
+		return keys[v[i]] < keys[v[j]]
+	})
+	return v
+}
+{{end}}
+// join{{.st}} formats the elements with format, joined by ", " inside brackets.
+func join{{.st}}(elements []{{.tp}}, format string) string {
+	v := make([]string, len(elements))
+	for i, element := range elements {
+		v[i] = fmt.Sprintf(format, element)
 	}
 	return fmt.Sprintf("[%s]", strings.Join(v, ", "))
 }
-`
+
+// format{{.st}} implements Format of the sets of {{.tp}}, constructor is the
+// name of the function which creates the set.
+func format{{.st}}(f fmt.State, verb rune, elements []{{.tp}}, constructor string) {
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		v := make([]string, len(elements))
+		for i, element := range elements {
+			v[i] = fmt.Sprintf("%#v", element)
+		}
+		fmt.Fprintf(f, "{{.pkg}}.%s(%s)", constructor, strings.Join(v, ", "))
+	case verb == 's':
+		fmt.Fprintf(f, fmt.FormatString(f, verb), join{{.st}}(elements, "%v"))
+	case verb == 'v' && f.Flag('+'):
+		fmt.Fprintf(f, "%s (size: %d)", join{{.st}}(elements, fmt.FormatString(f, verb)), len(elements))
+	default:
+		fmt.Fprint(f, join{{.st}}(elements, fmt.FormatString(f, verb)))
+	}
+}
+
+// MarshalJSON implements json.Marshaler, it encodes {{.st}} as a JSON array
+// sorted by the encoding of the elements, so the output is deterministic.
+// A nil {{.st}} encodes as null.
+func (s {{.st}}) MarshalJSON() ([]byte, error) {
+	if s == nil {
+		return []byte("null"), nil
+	}
+	v := make([][]byte, 0, len(s))
+	for element := range s {
+		b, err := json.Marshal(element)
+		if err != nil {
+			return nil, err
+		}
+		v = append(v, b)
+	}
+	sort.Slice(v, func(i, j int) bool {
+		return bytes.Compare(v[i], v[j]) < 0
+	})
+	var buf bytes.Buffer
+	buf.WriteByte('[')
+	buf.Write(bytes.Join(v, []byte{','}))
+	buf.WriteByte(']')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, it replaces {{.st}} with the
+// elements of a JSON array, merging duplicate elements. null decodes to a nil {{.st}}.
+func (s *{{.st}}) UnmarshalJSON(data []byte) error {
+	var v []{{.tp}}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	if v == nil {
+		*s = nil
+		return nil
+	}
+	u := New{{.st}}WithSize(len(v))
+	u.Add(v...)
+	*s = u
+	return nil
+}
+
+// MarshalYAML implements the yaml.Marshaler interface of the common YAML
+// libraries, it encodes {{.st}} as a sequence in the order of String.
+// A nil {{.st}} encodes as null.
+func (s {{.st}}) MarshalYAML() (interface{}, error) {
+	if s == nil {
+		return nil, nil
+	}
+	return s.sorted(), nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface of the common YAML
+// libraries, it replaces {{.st}} with the elements of a sequence, merging
+// duplicate elements. null decodes to a nil {{.st}}.
+func (s *{{.st}}) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var v []{{.tp}}
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	if v == nil {
+		*s = nil
+		return nil
+	}
+	u := New{{.st}}WithSize(len(v))
+	u.Add(v...)
+	*s = u
+	return nil
+}
+
+// Union{{.st}} returns the union of all the sets.
+// For example:
+// a = {a, b}
+// b = {b, c}
+// c = {d}
+// Union{{.st}}(a, b, c) = {a, b, c, d}
+func Union{{.st}}(sets ...{{.st}}) {{.st}} {
+	// the sum of sizes is the upper bound of the union, pre-sizing with it
+	// means the result never grows
+	size := 0
+	for _, s := range sets {
+		size += len(s)
+	}
+	u := New{{.st}}WithSize(size)
+	for _, s := range sets {
+		u.UnionWith(s)
+	}
+	return u
+}
+
+// Intersect{{.st}} returns the intersection of all the sets, it returns an
+// empty {{.st}} when no set is given.
+// For example:
+// a = {a, b, c}
+// b = {b, c, d}
+// c = {c, d}
+// Intersect{{.st}}(a, b, c) = {c}
+func Intersect{{.st}}(sets ...{{.st}}) {{.st}} {
+	if len(sets) == 0 {
+		return New{{.st}}()
+	}
+	// iterate from the smallest set, the intersection is no larger than it
+	// and every later IntersectWith only scans the shrinking result
+	sorted := make([]{{.st}}, len(sets))
+	copy(sorted, sets)
+	sort.Slice(sorted, func(i, j int) bool {
+		return len(sorted[i]) < len(sorted[j])
+	})
+	u := sorted[0].Copy()
+	for _, s := range sorted[1:] {
+		if len(u) == 0 {
+			break
+		}
+		u.IntersectWith(s)
+	}
+	return u
+}
+
+// Difference{{.st}} returns the elements of {{.st}} s which are in none of the sets.
+// For example:
+// s = {a, b, c, d}
+// a = {a}
+// b = {c, e}
+// Difference{{.st}}(s, a, b) = {b, d}
+func Difference{{.st}}(s {{.st}}, sets ...{{.st}}) {{.st}} {
+	u := s.Copy()
+	for _, t := range sets {
+		if len(u) == 0 {
+			break
+		}
+		u.DifferenceWith(t)
+	}
+	return u
+}
+{{if .sync}}
+// Sync{{.st}} is a {{.st}} which is safe for concurrent use by multiple goroutines.
+// Reads are guarded by a read lock and writes by a write lock of a
+// sync.RWMutex. The zero value is an empty Sync{{.st}} ready to use.
+// A Sync{{.st}} must not be copied after first use.
+type Sync{{.st}} struct {
+	mu sync.RWMutex
+	s  {{.st}}
+}
+
+// NewSync{{.st}} initializes a new Sync{{.st}}.
+func NewSync{{.st}}(elements ...{{.tp}}) *Sync{{.st}} {
+	return &Sync{{.st}}{s: New{{.st}}(elements...)}
+}
+
+// NewSync{{.st}}WithSize initializes a new Sync{{.st}} with the specified size.
+func NewSync{{.st}}WithSize(size int) *Sync{{.st}} {
+	return &Sync{{.st}}{s: New{{.st}}WithSize(size)}
+}
+
+// set returns the underlying {{.st}}, initializing it if necessary.
+// the caller must hold the write lock.
+func (s *Sync{{.st}}) set() {{.st}} {
+	if s.s == nil {
+		s.s = New{{.st}}()
+	}
+	return s.s
+}
+
+// Add adds the elements to Sync{{.st}}, if it is not present already.
+func (s *Sync{{.st}}) Add(elements ...{{.tp}}) {
+	s.mu.Lock()
+	s.set().Add(elements...)
+	s.mu.Unlock()
+}
+
+// AddIfAbsent adds the element to Sync{{.st}} if it is not present already.
+// it returns true if the element was added, and false if it existed.
+// the check and the addition happen atomically.
+func (s *Sync{{.st}}) AddIfAbsent(element {{.tp}}) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.s.Has(element) {
+		return false
+	}
+	s.set()[element] = struct{}{}
+	return true
+}
+
+// Remove removes the element from Sync{{.st}}, if it is present.
+func (s *Sync{{.st}}) Remove(elements ...{{.tp}}) {
+	s.mu.Lock()
+	s.s.Remove(elements...)
+	s.mu.Unlock()
+}
+
+// Pop returns an arbitrary element of Sync{{.st}}, deleting it from Sync{{.st}}.
+// The second value is a bool that is true if the elements existed in
+// the Sync{{.st}}, and false if not.
+func (s *Sync{{.st}}) Pop() ({{.tp}}, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.s.Pop()
+}
+
+// PopN removes up to n arbitrary elements from Sync{{.st}} and returns them,
+// the elements are removed atomically.
+func (s *Sync{{.st}}) PopN(n int) []{{.tp}} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if n > len(s.s) {
+		n = len(s.s)
+	}
+	if n <= 0 {
+		return nil
+	}
+	dest := make([]{{.tp}}, 0, n)
+	for k := range s.s {
+		if len(dest) == n {
+			break
+		}
+		delete(s.s, k)
+		dest = append(dest, k)
+	}
+	return dest
+}
+
+// Size returns the number of elements in Sync{{.st}}.
+func (s *Sync{{.st}}) Size() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.s)
+}
+
+// IsEmpty returns whether the Sync{{.st}} is Empty.
+func (s *Sync{{.st}}) IsEmpty() bool {
+	return s.Size() == 0
+}
+
+// Clear removes all items from the Sync{{.st}}.
+func (s *Sync{{.st}}) Clear() {
+	s.mu.Lock()
+	s.s = New{{.st}}()
+	s.mu.Unlock()
+}
+
+// Has judges the specified element whether exists in the Sync{{.st}}.
+// it returns true if existed, and false if not.
+func (s *Sync{{.st}}) Has(element {{.tp}}) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.s.Has(element)
+}
+
+// HasAll looks for the specified elements to judge
+// whether all exist in the Sync{{.st}}.
+// it returns true if existed, and false if not.
+func (s *Sync{{.st}}) HasAll(elements ...{{.tp}}) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.s.HasAll(elements...)
+}
+
+// HasAny looks for the specified elements to judge
+// whether at least one of the element exists in the Sync{{.st}}.
+// it returns true if existed, and false if not.
+func (s *Sync{{.st}}) HasAny(elements ...{{.tp}}) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.s.HasAny(elements...)
+}
+
+// Snapshot returns a {{.st}} holding the elements of Sync{{.st}} at one point in time,
+// later changes of Sync{{.st}} are not reflected in it.
+func (s *Sync{{.st}}) Snapshot() {{.st}} {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.s.Copy()
+}
+
+// List returns the all elements as a slice, taken at one point in time.
+func (s *Sync{{.st}}) List() []{{.tp}} {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.s.List()
+}
+
+// SortedList returns the all elements as a slice sorted by less func.
+func (s *Sync{{.st}}) SortedList(less func(i, j {{.tp}}) bool) []{{.tp}} {
+	return s.Snapshot().SortedList(less)
+}
+
+// EachE traverses a snapshot of the elements in the Sync{{.st}}, calling do func
+// for each member. the lock is not held while do runs, so do may modify
+// the Sync{{.st}}, those changes are not visible to the traversal.
+// the cycle will be stopped when the do func returns error.
+// if err is ErrBreakEach, break the cycle and return nil,
+// else, break the cycle and return error.
+func (s *Sync{{.st}}) EachE(do func(i {{.tp}}) error) error {
+	return s.Snapshot().EachE(do)
+}
+
+// Each traverses a snapshot of the elements in the Sync{{.st}}, calling do func
+// for each member. the lock is not held while do runs, so do may modify
+// the Sync{{.st}}, those changes are not visible to the traversal.
+func (s *Sync{{.st}}) Each(do func(i {{.tp}})) {
+	s.Snapshot().Each(do)
+}
+{{if .iter}}
+// All returns an iterator over a snapshot of the elements in the
+// Sync{{.st}}, the lock is not held while the loop body runs.
+func (s *Sync{{.st}}) All() iter.Seq[{{.tp}}] {
+	return s.Snapshot().All()
+}
+
+// Sorted returns an iterator over a snapshot of the elements in the
+// Sync{{.st}} in the order of String.
+func (s *Sync{{.st}}) Sorted() iter.Seq[{{.tp}}] {
+	return s.Snapshot().Sorted()
+}
+
+// CollectSync{{.st}} initializes a new Sync{{.st}} with the elements produced
+// by seq.
+func CollectSync{{.st}}(seq iter.Seq[{{.tp}}]) *Sync{{.st}} {
+	return &Sync{{.st}}{s: Collect{{.st}}(seq)}
+}
+{{end}}
+// Filter returns a new Sync{{.st}} with the elements of Sync{{.st}} s for
+// which pred returns true. pred runs on a snapshot without holding the lock.
+func (s *Sync{{.st}}) Filter(pred func(i {{.tp}}) bool) *Sync{{.st}} {
+	return &Sync{{.st}}{s: s.Snapshot().Filter(pred)}
+}
+
+// Partition returns two new Sync{{.st}}s, the first with the elements of
+// Sync{{.st}} s for which pred returns true, the second with the others.
+func (s *Sync{{.st}}) Partition(pred func(i {{.tp}}) bool) (*Sync{{.st}}, *Sync{{.st}}) {
+	in, out := s.Snapshot().Partition(pred)
+	return &Sync{{.st}}{s: in}, &Sync{{.st}}{s: out}
+}
+
+// Any reports whether pred returns true for any element of a snapshot of
+// the Sync{{.st}}.
+func (s *Sync{{.st}}) Any(pred func(i {{.tp}}) bool) bool {
+	return s.Snapshot().Any(pred)
+}
+
+// Every reports whether pred returns true for every element of a snapshot
+// of the Sync{{.st}}.
+func (s *Sync{{.st}}) Every(pred func(i {{.tp}}) bool) bool {
+	return s.Snapshot().Every(pred)
+}
+
+// Find returns an arbitrary element of a snapshot of the Sync{{.st}} for
+// which pred returns true. The second value is false if there is no such
+// element.
+func (s *Sync{{.st}}) Find(pred func(i {{.tp}}) bool) ({{.tp}}, bool) {
+	return s.Snapshot().Find(pred)
+}
+
+// Count returns the number of elements of a snapshot of the Sync{{.st}} for
+// which pred returns true.
+func (s *Sync{{.st}}) Count(pred func(i {{.tp}}) bool) int {
+	return s.Snapshot().Count(pred)
+}
+
+// Union returns the union of Sync{{.st}} s and t.
+func (s *Sync{{.st}}) Union(t *Sync{{.st}}) *Sync{{.st}} {
+	// snapshot t before locking s, holding both locks at once could
+	// deadlock against a concurrent t.Union(s)
+	u := t.Snapshot()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	u.UnionWith(s.s)
+	return &Sync{{.st}}{s: u}
+}
+
+// Difference returns the difference of Sync{{.st}} s and t.
+func (s *Sync{{.st}}) Difference(t *Sync{{.st}}) *Sync{{.st}} {
+	u := t.Snapshot()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return &Sync{{.st}}{s: s.s.Difference(u)}
+}
+
+// Intersection returns the intersection of Sync{{.st}} s and t.
+func (s *Sync{{.st}}) Intersection(t *Sync{{.st}}) *Sync{{.st}} {
+	u := t.Snapshot()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	u.IntersectWith(s.s)
+	return &Sync{{.st}}{s: u}
+}
+
+// SymmetricDifference returns a new Sync{{.st}} with the elements that are either in this Sync{{.st}}
+// or in the given Sync{{.st}}, but not in both.
+func (s *Sync{{.st}}) SymmetricDifference(t *Sync{{.st}}) *Sync{{.st}} {
+	u := t.Snapshot()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	u.SymmetricDifferenceWith(s.s)
+	return &Sync{{.st}}{s: u}
+}
+
+// UnionWith adds all elements of Sync{{.st}} t to Sync{{.st}} s atomically.
+func (s *Sync{{.st}}) UnionWith(t *Sync{{.st}}) {
+	u := t.Snapshot()
+	s.mu.Lock()
+	s.set().UnionWith(u)
+	s.mu.Unlock()
+}
+
+// DifferenceWith removes all elements of Sync{{.st}} t from Sync{{.st}} s atomically.
+func (s *Sync{{.st}}) DifferenceWith(t *Sync{{.st}}) {
+	u := t.Snapshot()
+	s.mu.Lock()
+	s.s.DifferenceWith(u)
+	s.mu.Unlock()
+}
+
+// IntersectWith removes the elements of Sync{{.st}} s which are not in Sync{{.st}} t atomically.
+func (s *Sync{{.st}}) IntersectWith(t *Sync{{.st}}) {
+	u := t.Snapshot()
+	s.mu.Lock()
+	s.s.IntersectWith(u)
+	s.mu.Unlock()
+}
+
+// SymmetricDifferenceWith keeps the elements that are either in Sync{{.st}} s or in
+// Sync{{.st}} t, but not in both, atomically.
+func (s *Sync{{.st}}) SymmetricDifferenceWith(t *Sync{{.st}}) {
+	u := t.Snapshot()
+	s.mu.Lock()
+	s.set().SymmetricDifferenceWith(u)
+	s.mu.Unlock()
+}
+
+// IsSubset predicates that tests whether the Sync{{.st}} s is a subset of Sync{{.st}} t.
+func (s *Sync{{.st}}) IsSubset(t *Sync{{.st}}) bool {
+	u := t.Snapshot()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.s.IsSubset(u)
+}
+
+// IsSuperset predicates that tests whether the Sync{{.st}} s is a super of Sync{{.st}} t.
+func (s *Sync{{.st}}) IsSuperset(t *Sync{{.st}}) bool {
+	u := t.Snapshot()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return u.IsSubset(s.s)
+}
+
+// Equal predicates that tests whether the Sync{{.st}} s equals of Sync{{.st}} t.
+func (s *Sync{{.st}}) Equal(t *Sync{{.st}}) bool {
+	u := t.Snapshot()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.s.Equal(u)
+}
+
+// Copy returns new Sync{{.st}} that clones from Sync{{.st}}.
+func (s *Sync{{.st}}) Copy() *Sync{{.st}} {
+	return &Sync{{.st}}{s: s.Snapshot()}
+}
+
+// String returns a string representation of Sync{{.st}}
+func (s *Sync{{.st}}) String() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.s.String()
+}
+
+// Format implements fmt.Formatter, it formats a snapshot of the Sync{{.st}}
+// as {{.st}}.Format does.
+func (s *Sync{{.st}}) Format(f fmt.State, verb rune) {
+	format{{.st}}(f, verb, s.Snapshot().sorted(), "NewSync{{.st}}")
+}
+
+// MarshalJSON implements json.Marshaler, it encodes a snapshot of the Sync{{.st}}
+// as {{.st}}.MarshalJSON does.
+func (s *Sync{{.st}}) MarshalJSON() ([]byte, error) {
+	return s.Snapshot().MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler, it replaces the Sync{{.st}} with the
+// elements of a JSON array as {{.st}}.UnmarshalJSON does.
+func (s *Sync{{.st}}) UnmarshalJSON(data []byte) error {
+	var u {{.st}}
+	if err := u.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	s.s = u
+	s.mu.Unlock()
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler, it encodes a snapshot of the
+// Sync{{.st}} as {{.st}}.MarshalYAML does.
+func (s *Sync{{.st}}) MarshalYAML() (interface{}, error) {
+	return s.Snapshot().MarshalYAML()
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, it replaces the Sync{{.st}} with
+// the elements of a sequence as {{.st}}.UnmarshalYAML does.
+func (s *Sync{{.st}}) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var u {{.st}}
+	if err := u.UnmarshalYAML(unmarshal); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	s.s = u
+	s.mu.Unlock()
+	return nil
+}
+{{end}}`
@@ -0,0 +1,21 @@
+package model
+
+// ID and the refs are declared here so that a key type of the package has
+// to be qualified when it is imported.
+type ID int
+
+const refs = 2
+
+// User is the element of the sets generated with -i by the tests.
+type User struct {
+	ID   ID
+	Refs [2]ID
+	Tags [refs]string
+	id   int
+}
+
+type Account struct {
+	Name string
+}
+
+type A struct{}
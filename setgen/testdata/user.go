@@ -0,0 +1,40 @@
+package gen
+
+// Example, Node and User are the elements of the sets generated by the tests into
+// package gen, which are type-checked with this file.
+type Example struct {
+	Name string
+}
+
+func (e Example) Hash() uint64 {
+	return uint64(len(e.Name))
+}
+
+func (e Example) Equal(other Example) bool {
+	return e.Name == other.Name
+}
+
+type User struct {
+	ID   int
+	Name string
+}
+
+func (u User) Hash() uint64 {
+	return uint64(u.ID)
+}
+
+func (u User) Equal(other User) bool {
+	return u.ID == other.ID
+}
+
+type Node struct {
+	Name string
+}
+
+func (n *Node) Hash() uint64 {
+	return uint64(len(n.Name))
+}
+
+func (n *Node) Equal(other *Node) bool {
+	return n.Name == other.Name
+}
@@ -0,0 +1,569 @@
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package set
+
+import (
+	"errors"
+	"fmt"
+	"hash/maphash"
+	"reflect"
+	"runtime"
+	"sync"
+	"unsafe"
+)
+
+// stringSeed seeds the hash of string elements. It is shared by every
+// Sharded so that equal elements of two sets land in the same shard index,
+// which lets the set operations work shard by shard.
+var stringSeed = maphash.MakeSeed()
+
+// shard is one of the striped maps of a Sharded, it is padded to a cache
+// line so that the locks of neighbouring shards do not share one.
+type shard[T Ordered] struct {
+	mu sync.RWMutex
+	s  Set[T]
+	_  [64 - (unsafe.Sizeof(sync.RWMutex{})+unsafe.Sizeof(map[int]struct{}{}))%64]byte
+}
+
+// Sharded is a Set which is safe for concurrent use by multiple goroutines,
+// it stripes the elements over a power of two number of shards by a hash of
+// the element, each shard is guarded by its own sync.RWMutex. Unlike Sync,
+// writers of different shards do not block each other.
+//
+// Operations which read more than one shard, such as Size, List and Each,
+// lock one shard at a time, so with concurrent writers their result may not
+// reflect the Sharded at a single point in time.
+//
+// The zero value is an empty Sharded ready to use, it allocates the shards of
+// NewSharded when it is first used. A Sharded must not be copied after
+// first use.
+type Sharded[T Ordered] struct {
+	once   sync.Once
+	hash   func(T) uint64
+	shards []shard[T]
+}
+
+// NewSharded initializes a new Sharded with four shards per CPU.
+func NewSharded[T Ordered](elements ...T) *Sharded[T] {
+	s := NewShardedWithShards[T](4 * runtime.GOMAXPROCS(0))
+	s.Add(elements...)
+	return s
+}
+
+// NewShardedWithShards initializes a new Sharded with the specified number of
+// shards, which is rounded up to a power of two.
+func NewShardedWithShards[T Ordered](shards int) *Sharded[T] {
+	n := 1
+	for n < shards {
+		n <<= 1
+	}
+	s := &Sharded[T]{
+		hash:   hasher[T](),
+		shards: make([]shard[T], n),
+	}
+	for i := range s.shards {
+		s.shards[i].s = New[T]()
+	}
+	return s
+}
+
+// init allocates the shards of a zero Sharded, it is called by the methods
+// which access the shards.
+func (s *Sharded[T]) init() {
+	s.once.Do(func() {
+		if s.shards == nil {
+			u := NewShardedWithShards[T](4 * runtime.GOMAXPROCS(0))
+			s.hash, s.shards = u.hash, u.shards
+		}
+	})
+}
+
+// hasher returns the hash func of the elements of type T.
+func hasher[T Ordered]() func(T) uint64 {
+	var zero T
+	if reflect.TypeOf(zero).Kind() == reflect.String {
+		return func(element T) uint64 {
+			return maphash.String(stringSeed, *(*string)(unsafe.Pointer(&element)))
+		}
+	}
+	return func(element T) uint64 {
+		// +0 and -0 are equal, they must hash the same
+		if element == zero {
+			element = zero
+		}
+		var x uint64
+		switch unsafe.Sizeof(element) {
+		case 1:
+			x = uint64(*(*uint8)(unsafe.Pointer(&element)))
+		case 2:
+			x = uint64(*(*uint16)(unsafe.Pointer(&element)))
+		case 4:
+			x = uint64(*(*uint32)(unsafe.Pointer(&element)))
+		default:
+			x = *(*uint64)(unsafe.Pointer(&element))
+		}
+		// the finalizer of splitmix64 spreads the low bits of small integers
+		x ^= x >> 30
+		x *= 0xbf58476d1ce4e5b9
+		x ^= x >> 27
+		x *= 0x94d049bb133111eb
+		x ^= x >> 31
+		return x
+	}
+}
+
+// shard returns the shard the element belongs to.
+func (s *Sharded[T]) shard(element T) *shard[T] {
+	s.init()
+	return &s.shards[s.hash(element)&uint64(len(s.shards)-1)]
+}
+
+// Add adds the elements to Sharded, if it is not present already.
+func (s *Sharded[T]) Add(elements ...T) {
+	for _, element := range elements {
+		sh := s.shard(element)
+		sh.mu.Lock()
+		sh.s[element] = struct{}{}
+		sh.mu.Unlock()
+	}
+}
+
+// AddIfAbsent adds the element to Sharded if it is not present already.
+// it returns true if the element was added, and false if it existed.
+// the check and the addition happen atomically.
+func (s *Sharded[T]) AddIfAbsent(element T) bool {
+	sh := s.shard(element)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	if sh.s.Has(element) {
+		return false
+	}
+	sh.s[element] = struct{}{}
+	return true
+}
+
+// Remove removes the element from Sharded, if it is present.
+func (s *Sharded[T]) Remove(elements ...T) {
+	for _, element := range elements {
+		sh := s.shard(element)
+		sh.mu.Lock()
+		delete(sh.s, element)
+		sh.mu.Unlock()
+	}
+}
+
+// Pop returns an arbitrary element of Sharded, deleting it from Sharded.
+// The second value is a bool that is true if the elements existed in
+// the Sharded, and false if not.
+func (s *Sharded[T]) Pop() (T, bool) {
+	s.init()
+	for i := range s.shards {
+		sh := &s.shards[i]
+		sh.mu.Lock()
+		k, ok := sh.s.Pop()
+		sh.mu.Unlock()
+		if ok {
+			return k, true
+		}
+	}
+	var zero T
+	return zero, false
+}
+
+// Size returns the number of elements in Sharded.
+func (s *Sharded[T]) Size() int {
+	s.init()
+	size := 0
+	for i := range s.shards {
+		sh := &s.shards[i]
+		sh.mu.RLock()
+		size += len(sh.s)
+		sh.mu.RUnlock()
+	}
+	return size
+}
+
+// IsEmpty returns whether the Sharded is Empty.
+func (s *Sharded[T]) IsEmpty() bool {
+	s.init()
+	for i := range s.shards {
+		sh := &s.shards[i]
+		sh.mu.RLock()
+		n := len(sh.s)
+		sh.mu.RUnlock()
+		if n > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Clear removes all items from the Sharded.
+func (s *Sharded[T]) Clear() {
+	s.init()
+	for i := range s.shards {
+		sh := &s.shards[i]
+		sh.mu.Lock()
+		sh.s = New[T]()
+		sh.mu.Unlock()
+	}
+}
+
+// Has judges the specified element whether exists in the Sharded.
+// it returns true if existed, and false if not.
+func (s *Sharded[T]) Has(element T) bool {
+	sh := s.shard(element)
+	sh.mu.RLock()
+	_, ok := sh.s[element]
+	sh.mu.RUnlock()
+	return ok
+}
+
+// HasAll looks for the specified elements to judge
+// whether all exist in the Sharded.
+// it returns true if existed, and false if not.
+func (s *Sharded[T]) HasAll(elements ...T) bool {
+	for _, element := range elements {
+		if !s.Has(element) {
+			return false
+		}
+	}
+	return true
+}
+
+// HasAny looks for the specified elements to judge
+// whether at least one of the element exists in the Sharded.
+// it returns true if existed, and false if not.
+func (s *Sharded[T]) HasAny(elements ...T) bool {
+	for _, element := range elements {
+		if s.Has(element) {
+			return true
+		}
+	}
+	return false
+}
+
+// Snapshot returns a Set holding the elements of Sharded, each shard is
+// copied under its own lock.
+func (s *Sharded[T]) Snapshot() Set[T] {
+	s.init()
+	u := NewWithSize[T](s.Size())
+	for i := range s.shards {
+		sh := &s.shards[i]
+		sh.mu.RLock()
+		u.UnionWith(sh.s)
+		sh.mu.RUnlock()
+	}
+	return u
+}
+
+// List returns the all elements as a slice.
+func (s *Sharded[T]) List() []T {
+	s.init()
+	var dest []T
+	for i := range s.shards {
+		sh := &s.shards[i]
+		sh.mu.RLock()
+		for k := range sh.s {
+			dest = append(dest, k)
+		}
+		sh.mu.RUnlock()
+	}
+	return dest
+}
+
+// SortedList returns the all elements as a slice sorted by less func.
+func (s *Sharded[T]) SortedList(less func(i, j T) bool) []T {
+	return s.Snapshot().SortedList(less)
+}
+
+// EachE traverses the elements in the Sharded, calling do func for each
+// Sharded member. Each shard is traversed on a snapshot, the lock is not held
+// while do runs, so do may modify the Sharded.
+// the cycle will be stopped when the do func returns error.
+// if err is ErrBreakEach, break the cycle and return nil,
+// else, break the cycle and return error.
+func (s *Sharded[T]) EachE(do func(i T) error) error {
+	s.init()
+	for i := range s.shards {
+		sh := &s.shards[i]
+		sh.mu.RLock()
+		snapshot := sh.s.List()
+		sh.mu.RUnlock()
+		for _, k := range snapshot {
+			if err := do(k); err != nil {
+				if err == ErrBreakEach {
+					return nil
+				}
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Each traverses the elements in the Sharded, calling do func for each
+// Sharded member. Each shard is traversed on a snapshot, the lock is not held
+// while do runs, so do may modify the Sharded.
+func (s *Sharded[T]) Each(do func(i T)) {
+	_ = s.EachE(func(i T) error {
+		do(i)
+		return nil
+	})
+}
+
+// with returns a new Sharded with the same number of shards as Sharded s,
+// holding the elements.
+func (s *Sharded[T]) with(elements ...T) *Sharded[T] {
+	s.init()
+	u := NewShardedWithShards[T](len(s.shards))
+	u.Add(elements...)
+	return u
+}
+
+// Filter returns a new Sharded with the elements of Sharded s for which pred
+// returns true.
+func (s *Sharded[T]) Filter(pred func(i T) bool) *Sharded[T] {
+	in, _ := partitionElements(s.Each, pred)
+	return s.with(in...)
+}
+
+// Partition returns two new Shardeds, the first with the elements of Sharded s
+// for which pred returns true, the second with the others.
+func (s *Sharded[T]) Partition(pred func(i T) bool) (*Sharded[T], *Sharded[T]) {
+	in, out := partitionElements(s.Each, pred)
+	return s.with(in...), s.with(out...)
+}
+
+// Any reports whether pred returns true for any element of Sharded s, the
+// traversal stops at the first such element.
+func (s *Sharded[T]) Any(pred func(i T) bool) bool {
+	_, ok := findElement(s.EachE, pred)
+	return ok
+}
+
+// Every reports whether pred returns true for every element of Sharded s,
+// the traversal stops at the first element for which pred returns false.
+func (s *Sharded[T]) Every(pred func(i T) bool) bool {
+	_, ok := findElement(s.EachE, func(i T) bool {
+		return !pred(i)
+	})
+	return !ok
+}
+
+// Find returns an arbitrary element of Sharded s for which pred returns true.
+// The second value is false if there is no such element.
+func (s *Sharded[T]) Find(pred func(i T) bool) (T, bool) {
+	return findElement(s.EachE, pred)
+}
+
+// Count returns the number of elements of Sharded s for which pred returns
+// true.
+func (s *Sharded[T]) Count(pred func(i T) bool) int {
+	return countElements(s.Each, pred)
+}
+
+// partition returns the elements of Sharded s split into n sets, the i-th set
+// holds the elements which belong to the i-th shard of a Sharded with n shards.
+// each shard of s is copied under its own lock.
+func (s *Sharded[T]) partition(n int) []Set[T] {
+	s.init()
+	parts := make([]Set[T], n)
+	if n == len(s.shards) {
+		for i := range s.shards {
+			sh := &s.shards[i]
+			sh.mu.RLock()
+			parts[i] = sh.s.Copy()
+			sh.mu.RUnlock()
+		}
+		return parts
+	}
+	for i := range parts {
+		parts[i] = New[T]()
+	}
+	s.Each(func(element T) {
+		parts[s.hash(element)&uint64(n-1)][element] = struct{}{}
+	})
+	return parts
+}
+
+// combine returns a new Sharded whose shards are op of the shards of s and
+// the elements of t which belong to them.
+func (s *Sharded[T]) combine(t *Sharded[T], op func(s, t Set[T]) Set[T]) *Sharded[T] {
+	s.init()
+	// t is copied before any shard of s is locked, the locks of two sets
+	// are never held at once
+	parts := t.partition(len(s.shards))
+	u := NewShardedWithShards[T](len(s.shards))
+	for i := range s.shards {
+		sh := &s.shards[i]
+		sh.mu.RLock()
+		u.shards[i].s = op(sh.s, parts[i])
+		sh.mu.RUnlock()
+	}
+	return u
+}
+
+// update applies op to each shard of s and the elements of t which belong to it.
+func (s *Sharded[T]) update(t *Sharded[T], op func(s, t Set[T])) {
+	s.init()
+	parts := t.partition(len(s.shards))
+	for i := range s.shards {
+		sh := &s.shards[i]
+		sh.mu.Lock()
+		op(sh.s, parts[i])
+		sh.mu.Unlock()
+	}
+}
+
+// Union returns the union of Sharded s and t.
+func (s *Sharded[T]) Union(t *Sharded[T]) *Sharded[T] {
+	return s.combine(t, Set[T].Union)
+}
+
+// Difference returns the difference of Sharded s and t.
+func (s *Sharded[T]) Difference(t *Sharded[T]) *Sharded[T] {
+	return s.combine(t, Set[T].Difference)
+}
+
+// Intersection returns the intersection of Sharded s and t.
+func (s *Sharded[T]) Intersection(t *Sharded[T]) *Sharded[T] {
+	return s.combine(t, Set[T].Intersection)
+}
+
+// SymmetricDifference returns a new Sharded with the elements that are either in this Sharded
+// or in the given Sharded, but not in both.
+func (s *Sharded[T]) SymmetricDifference(t *Sharded[T]) *Sharded[T] {
+	return s.combine(t, Set[T].SymmetricDifference)
+}
+
+// UnionWith adds all elements of Sharded t to Sharded s.
+func (s *Sharded[T]) UnionWith(t *Sharded[T]) {
+	s.update(t, Set[T].UnionWith)
+}
+
+// DifferenceWith removes all elements of Sharded t from Sharded s.
+func (s *Sharded[T]) DifferenceWith(t *Sharded[T]) {
+	s.update(t, Set[T].DifferenceWith)
+}
+
+// IntersectWith removes the elements of Sharded s which are not in Sharded t.
+func (s *Sharded[T]) IntersectWith(t *Sharded[T]) {
+	s.update(t, Set[T].IntersectWith)
+}
+
+// SymmetricDifferenceWith keeps the elements that are either in Sharded s or in
+// Sharded t, but not in both.
+func (s *Sharded[T]) SymmetricDifferenceWith(t *Sharded[T]) {
+	s.update(t, Set[T].SymmetricDifferenceWith)
+}
+
+// IsSubset predicates that tests whether the Sharded s is a subset of Sharded t.
+func (s *Sharded[T]) IsSubset(t *Sharded[T]) bool {
+	return s.EachE(func(i T) error {
+		if !t.Has(i) {
+			return errNotSubset
+		}
+		return nil
+	}) == nil
+}
+
+// errNotSubset stops the traversal of IsSubset.
+var errNotSubset = errors.New("not subset")
+
+// IsSuperset predicates that tests whether the Sharded s is a super of Sharded t.
+func (s *Sharded[T]) IsSuperset(t *Sharded[T]) bool {
+	return t.IsSubset(s)
+}
+
+// Equal predicates that tests whether the Sharded s equals of Sharded t.
+func (s *Sharded[T]) Equal(t *Sharded[T]) bool {
+	return s.Size() == t.Size() && s.IsSubset(t)
+}
+
+// Copy returns new Sharded that clones from Sharded.
+func (s *Sharded[T]) Copy() *Sharded[T] {
+	s.init()
+	u := NewShardedWithShards[T](len(s.shards))
+	for i := range s.shards {
+		sh := &s.shards[i]
+		sh.mu.RLock()
+		u.shards[i].s = sh.s.Copy()
+		sh.mu.RUnlock()
+	}
+	return u
+}
+
+// String returns a string representation of Sharded
+func (s *Sharded[T]) String() string {
+	return s.Snapshot().String()
+}
+
+// Format implements fmt.Formatter, it formats a snapshot of the Sharded, the
+// elements are in their natural order. See Set.Format for the verbs.
+func (s *Sharded[T]) Format(f fmt.State, verb rune) {
+	v := s.Snapshot().List()
+	sortElements(v)
+	formatElements(f, verb, v, "NewSharded["+typeName[T]()+"]")
+}
+
+// MarshalJSON implements json.Marshaler, it encodes a snapshot of the Sharded
+// as Set.MarshalJSON does.
+func (s *Sharded[T]) MarshalJSON() ([]byte, error) {
+	return s.Snapshot().MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler, it replaces the Sharded with
+// the elements of a JSON array as Set.UnmarshalJSON does, null empties the
+// Sharded. The replacement is not atomic, concurrent readers may observe a
+// partially filled Sharded.
+func (s *Sharded[T]) UnmarshalJSON(data []byte) error {
+	var u Set[T]
+	if err := u.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	s.Clear()
+	s.Add(u.List()...)
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler, it encodes a snapshot of the
+// Sharded as Set.MarshalYAML does.
+func (s *Sharded[T]) MarshalYAML() (interface{}, error) {
+	return s.Snapshot().MarshalYAML()
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, it replaces the Sharded with
+// the elements of a sequence, merging duplicate elements. The replacement is
+// not atomic, concurrent readers may observe a partially filled Sharded.
+func (s *Sharded[T]) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	v, err := unmarshalYAMLElements[T](unmarshal)
+	if err != nil {
+		return err
+	}
+	s.Clear()
+	s.Add(v...)
+	return nil
+}
@@ -0,0 +1,271 @@
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package set
+
+import (
+	"encoding/json"
+	"math"
+	"sort"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+func TestSharded(t *testing.T) {
+	s := NewShardedWithShards[int](3)
+	if len(s.shards) != 4 {
+		t.Errorf("expect shards rounded up to 4, but got: %d", len(s.shards))
+	}
+	if !s.IsEmpty() {
+		t.Errorf("expect empty set, but got: %s", s)
+	}
+	s.Add(1, 2, 3, 4, 5)
+	s.Remove(5)
+	validateSet(t, s.Snapshot(), []int{1, 2, 3, 4})
+	if !s.Has(1) || s.Has(5) || !s.HasAll(1, 4) || s.HasAll(1, 5) || !s.HasAny(5, 4) || s.HasAny(5, 6) {
+		t.Errorf("unexpected predicates on: %s", s)
+	}
+	if s.Size() != 4 || s.IsEmpty() {
+		t.Errorf("expect size: 4, but got: %d", s.Size())
+	}
+	if s.AddIfAbsent(1) || !s.AddIfAbsent(6) {
+		t.Errorf("unexpected AddIfAbsent result on: %s", s)
+	}
+	list := s.List()
+	sort.Ints(list)
+	sorted := s.SortedList(func(i, j int) bool { return i < j })
+	for i := range list {
+		if list[i] != sorted[i] {
+			t.Errorf("expect sorted list: %v, but got: %v", list, sorted)
+		}
+	}
+	c := s.Copy()
+	c.Add(9)
+	if s.Has(9) || !c.Has(6) {
+		t.Errorf("expect copy to be independent of origin set")
+	}
+	if k, ok := s.Pop(); !ok || s.Has(k) || s.Size() != 4 {
+		t.Errorf("expect Pop to remove an element, but got: %v, %v", k, ok)
+	}
+	s.Clear()
+	if _, ok := s.Pop(); ok || !s.IsEmpty() {
+		t.Errorf("expect empty set after Clear, but got: %s", s)
+	}
+}
+
+func TestSharded_Elements(t *testing.T) {
+	f := NewSharded(0.0, math.Copysign(0, -1), 1.5)
+	if f.Size() != 2 || !f.Has(math.Copysign(0, -1)) {
+		t.Errorf("expect +0 and -0 to be the same element, but got: %s", f)
+	}
+	s := NewSharded("a", "b", "c")
+	validateSet(t, s.Snapshot(), []string{"a", "b", "c"})
+	u := NewSharded[uint8](255, 0)
+	validateSet(t, u.Snapshot(), []uint8{0, 255})
+}
+
+func TestSharded_Algebra(t *testing.T) {
+	testcases := []struct {
+		name    string
+		sShards int
+		tShards int
+	}{
+		{name: "aligned shards", sShards: 8, tShards: 8},
+		{name: "unaligned shards", sShards: 8, tShards: 2},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		s, u := NewShardedWithShards[int](tc.sShards), NewShardedWithShards[int](tc.tShards)
+		s.Add(1, 2, 3, 4)
+		u.Add(3, 4, 5)
+		validateSet(t, s.Union(u).Snapshot(), []int{1, 2, 3, 4, 5})
+		validateSet(t, s.Difference(u).Snapshot(), []int{1, 2})
+		validateSet(t, s.Intersection(u).Snapshot(), []int{3, 4})
+		validateSet(t, s.SymmetricDifference(u).Snapshot(), []int{1, 2, 5})
+		if s.IsSubset(u) || s.IsSuperset(u) || s.Equal(u) || !s.Equal(s.Copy()) || !s.Intersection(u).IsSubset(u) {
+			t.Errorf("unexpected predicates on: %s and %s", s, u)
+		}
+
+		w := s.Copy()
+		w.UnionWith(u)
+		validateSet(t, w.Snapshot(), []int{1, 2, 3, 4, 5})
+		w.DifferenceWith(u)
+		validateSet(t, w.Snapshot(), []int{1, 2})
+		w.SymmetricDifferenceWith(u)
+		validateSet(t, w.Snapshot(), []int{1, 2, 3, 4, 5})
+		w.IntersectWith(s)
+		validateSet(t, w.Snapshot(), []int{1, 2, 3, 4})
+		w.SymmetricDifferenceWith(w)
+		if !w.IsEmpty() {
+			t.Errorf("expect empty set, but got: %s", w)
+		}
+	}
+}
+
+func TestSharded_Each(t *testing.T) {
+	s := NewSharded(1, 2, 3)
+	var visited []int
+	s.Each(func(i int) {
+		visited = append(visited, i)
+		s.Remove(i)
+	})
+	if len(visited) != 3 || !s.IsEmpty() {
+		t.Errorf("expect 3 visited and empty set, but got: %v and %s", visited, s)
+	}
+	s.Add(1, 2, 3)
+	visited = nil
+	err := s.EachE(func(i int) error {
+		visited = append(visited, i)
+		return ErrBreakEach
+	})
+	if err != nil || len(visited) != 1 {
+		t.Errorf("expect one visited and no error, but got: %v and %v", visited, err)
+	}
+	data, err := json.Marshal(s)
+	if err != nil || string(data) != "[1,2,3]" {
+		t.Errorf("expect json: [1,2,3], but got: %s, %v", data, err)
+	}
+}
+
+func TestSharded_ZeroValue(t *testing.T) {
+	var s Sharded[int]
+	if s.Has(1) || !s.IsEmpty() || s.Size() != 0 {
+		t.Errorf("expect empty set, but got: %s", &s)
+	}
+	s.Add(1, 2)
+	s.Remove(2)
+	validateSet(t, s.Snapshot(), []int{1})
+	if len(s.shards) != len(NewSharded[int]().shards) {
+		t.Errorf("expect the shards of NewSharded, but got: %d", len(s.shards))
+	}
+	var u Sharded[int]
+	u.UnionWith(NewSharded(3))
+	validateSet(t, u.Union(&s).Snapshot(), []int{1, 3})
+	var c Sharded[int]
+	validateSet(t, c.Copy().Snapshot(), []int{})
+
+	// the first use of a zero Sharded may be concurrent
+	var z Sharded[int]
+	var wg sync.WaitGroup
+	for w := 0; w < 4; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			z.Add(w)
+			z.Size()
+		}(w)
+	}
+	wg.Wait()
+	validateSet(t, z.Snapshot(), []int{0, 1, 2, 3})
+}
+
+func TestSharded_UnmarshalJSON(t *testing.T) {
+	var v struct {
+		S Sharded[int]
+		P *Sharded[string]
+	}
+	if err := json.Unmarshal([]byte(`{"S": [3, 1, 3], "P": ["a"]}`), &v); err != nil {
+		t.Fatalf("expect no error, but got: %v", err)
+	}
+	validateSet(t, v.S.Snapshot(), []int{1, 3})
+	validateSet(t, v.P.Snapshot(), []string{"a"})
+	data, err := json.Marshal(&v.S)
+	if err != nil || string(data) != "[1,3]" {
+		t.Errorf("expect json: [1,3], but got: %s, %v", data, err)
+	}
+	s := NewSharded(9)
+	if err := json.Unmarshal([]byte(`[1]`), s); err != nil {
+		t.Fatalf("expect no error, but got: %v", err)
+	}
+	validateSet(t, s.Snapshot(), []int{1})
+	if err := json.Unmarshal([]byte(`null`), s); err != nil || !s.IsEmpty() {
+		t.Errorf("expect null to empty the set, but got: %s, %v", s, err)
+	}
+	if err := json.Unmarshal([]byte(`["a"]`), s); err == nil {
+		t.Errorf("expect error for a string element, but got nil")
+	}
+}
+
+func TestSharded_Concurrent(t *testing.T) {
+	const workers, elements = 8, 2000
+	s, u := NewSharded[string](), NewSharded[string]()
+	added := make([]int, workers)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < elements; i++ {
+				k := strconv.Itoa(i)
+				if s.AddIfAbsent(k) {
+					added[w]++
+				}
+				s.Has(k)
+				u.Add(strconv.Itoa(i % 10))
+				if i%500 == 0 {
+					s.Size()
+					_ = s.Union(u)
+					u.UnionWith(s)
+					s.IsSubset(u)
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+	total := 0
+	for _, n := range added {
+		total += n
+	}
+	if total != elements || s.Size() != elements {
+		t.Errorf("expect %d elements added once, but got: %d and size %d", elements, total, s.Size())
+	}
+}
+
+// benchmarkAddHas mixes one Add with three Has calls over a fixed key space,
+// run it with -cpu to compare the throughput under contention.
+func benchmarkAddHas(b *testing.B, add func(int), has func(int) bool) {
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			k := (i * 7919) & 0xffff
+			if i%4 == 0 {
+				add(k)
+			} else {
+				has(k)
+			}
+			i++
+		}
+	})
+}
+
+func BenchmarkSharded_AddHas(b *testing.B) {
+	s := NewSharded[int]()
+	benchmarkAddHas(b, func(i int) { s.Add(i) }, s.Has)
+}
+
+func BenchmarkSync_AddHas(b *testing.B) {
+	s := NewSync[int]()
+	benchmarkAddHas(b, func(i int) { s.Add(i) }, s.Has)
+}
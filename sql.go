@@ -0,0 +1,370 @@
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package set
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrNull is returned when scanning a NULL column or a NULL array element
+// which the NullPolicy does not accept.
+var ErrNull = errors.New("unexpected null")
+
+// NullPolicy configures how a NULL column is scanned into a Set, and how a nil
+// Set is converted to a database value.
+type NullPolicy int
+
+const (
+	// NullAsNil scans NULL into a nil Set, and converts a nil Set to NULL.
+	NullAsNil NullPolicy = iota
+	// NullAsEmpty scans NULL into an empty Set, and converts a nil Set to an
+	// empty array.
+	NullAsEmpty
+	// NullReject fails to scan NULL with ErrNull, and converts a nil Set to an
+	// empty array.
+	NullReject
+)
+
+// SQLOptions configures how a Set is scanned from and converted to a
+// database value.
+type SQLOptions struct {
+	// Null is the policy of NULL columns and nil sets.
+	Null NullPolicy
+	// SkipNullElements drops the NULL elements of an array, by default they
+	// fail the scan with ErrNull.
+	SkipNullElements bool
+	// JSON converts the Set to a JSON array for JSON columns, by default it
+	// is converted to a Postgres array literal. Scan accepts both whatever
+	// the option.
+	JSON bool
+}
+
+// SQLSet implements sql.Scanner and driver.Valuer for a Set configured by
+// SQLOptions, it is returned by SQL.
+type SQLSet[T comparable] struct {
+	s    *Set[T]
+	opts SQLOptions
+}
+
+// SQL returns a sql.Scanner and driver.Valuer for Set s configured by opts,
+// which is given to Scan or to the arguments of a query in place of the Set.
+// For example:
+//
+//	rows.Scan(set.SQL(&tags, set.SQLOptions{Null: set.NullAsEmpty}))
+//	db.Exec(query, set.SQL(&tags, set.SQLOptions{JSON: true}))
+func SQL[T comparable](s *Set[T], opts SQLOptions) *SQLSet[T] {
+	return &SQLSet[T]{s: s, opts: opts}
+}
+
+// Scan implements sql.Scanner, it replaces the Set with the elements of
+// src, which is a Postgres array literal such as {a,"b c",NULL} or
+// [0:1]={a,b}, or a JSON array, given as a string or []byte. The Set is
+// left unchanged on error.
+func (q *SQLSet[T]) Scan(src interface{}) error {
+	var text string
+	switch src := src.(type) {
+	case nil:
+		return q.scanNull()
+	case string:
+		text = src
+	case []byte:
+		text = string(src)
+	default:
+		return fmt.Errorf("set: cannot scan %T into %s", src, setName[T]())
+	}
+	var v []T
+	var err error
+	switch text = strings.TrimSpace(text); {
+	case text == "null":
+		return q.scanNull()
+	case strings.HasPrefix(text, "[") && arrayBounds(text) == 0:
+		v, err = q.scanJSON(text)
+	default:
+		v, err = q.scanArray(text)
+	}
+	if err != nil {
+		return err
+	}
+	u := NewWithSize[T](len(v))
+	for _, element := range v {
+		u[element] = struct{}{}
+	}
+	*q.s = u
+	return nil
+}
+
+func (q *SQLSet[T]) scanNull() error {
+	switch q.opts.Null {
+	case NullAsEmpty:
+		*q.s = Set[T]{}
+	case NullReject:
+		return fmt.Errorf("set: scan %s: %w", setName[T](), ErrNull)
+	default:
+		*q.s = nil
+	}
+	return nil
+}
+
+// scanJSON returns the elements of a JSON array, JSON null elements are
+// NULL elements.
+func (q *SQLSet[T]) scanJSON(text string) ([]T, error) {
+	var raw []json.RawMessage
+	if err := json.Unmarshal([]byte(text), &raw); err != nil {
+		return nil, fmt.Errorf("set: scan JSON array: %w", err)
+	}
+	v := make([]T, 0, len(raw))
+	for i, r := range raw {
+		if bytes.Equal(r, []byte("null")) {
+			if q.opts.SkipNullElements {
+				continue
+			}
+			return nil, fmt.Errorf("set: scan JSON array element %d: %w", i, ErrNull)
+		}
+		element, err := unmarshalElements[T](append(append([]byte{'['}, r...), ']'))
+		if err != nil {
+			return nil, fmt.Errorf("set: scan JSON array element %d: %w", i, err)
+		}
+		v = append(v, element...)
+	}
+	return v, nil
+}
+
+// scanArray returns the elements of a one dimensional Postgres array literal.
+func (q *SQLSet[T]) scanArray(text string) ([]T, error) {
+	tokens, err := parseArray(text)
+	if err != nil {
+		return nil, err
+	}
+	v := make([]T, 0, len(tokens))
+	for _, token := range tokens {
+		if token.null {
+			if q.opts.SkipNullElements {
+				continue
+			}
+			return nil, fmt.Errorf("set: scan array element at byte %d: %w", token.pos, ErrNull)
+		}
+		element, err := parseElement[T](token.text)
+		if err != nil {
+			return nil, fmt.Errorf("set: scan array element %q at byte %d: %w", token.text, token.pos, err)
+		}
+		v = append(v, element)
+	}
+	return v, nil
+}
+
+// Value implements driver.Valuer, it converts the Set to a Postgres array
+// literal, or to a JSON array if SQLOptions.JSON is set, with the elements in
+// their natural order.
+func (q *SQLSet[T]) Value() (driver.Value, error) {
+	if *q.s == nil && q.opts.Null == NullAsNil {
+		return nil, nil
+	}
+	if q.opts.JSON {
+		if *q.s == nil {
+			return "[]", nil
+		}
+		data, err := EncodeJSON(*q.s, JSONOptions{Sorted: true})
+		if err != nil {
+			return nil, err
+		}
+		return string(data), nil
+	}
+	v := q.s.List()
+	sortElements(v)
+	var b strings.Builder
+	b.WriteByte('{')
+	for i, element := range v {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		if interface{}(element) == nil {
+			b.WriteString("NULL")
+			continue
+		}
+		text, err := formatElement(element)
+		if err != nil {
+			return nil, err
+		}
+		b.WriteString(quoteArrayElement(text))
+	}
+	b.WriteByte('}')
+	return b.String(), nil
+}
+
+// Scan implements sql.Scanner, it replaces the Set with the elements of a
+// Postgres array literal or a JSON array, NULL scans into a nil Set. Use SQL
+// to configure how NULL is handled.
+func (s *Set[T]) Scan(src interface{}) error {
+	return SQL(s, SQLOptions{}).Scan(src)
+}
+
+// Value implements driver.Valuer, it converts the Set to a Postgres array
+// literal such as {1,2,3}, a nil Set converts to NULL. Use SQL to convert it
+// to a JSON array.
+func (s Set[T]) Value() (driver.Value, error) {
+	return SQL(&s, SQLOptions{}).Value()
+}
+
+// arrayToken is an element of a Postgres array literal.
+type arrayToken struct {
+	text string
+	null bool
+	// pos is the byte offset of the element in the literal.
+	pos int
+}
+
+// arrayBounds returns the length of the dimension decoration, such as
+// [1:2]= in [1:2]={a,b}, that prefixes a Postgres array literal with
+// explicit bounds, or 0 if text has none.
+func arrayBounds(text string) int {
+	i := 0
+	digits := func() bool {
+		if i < len(text) && text[i] == '-' {
+			i++
+		}
+		start := i
+		for i < len(text) && text[i] >= '0' && text[i] <= '9' {
+			i++
+		}
+		return i > start
+	}
+	for i < len(text) && text[i] == '[' {
+		i++
+		if !digits() || i >= len(text) || text[i] != ':' {
+			return 0
+		}
+		i++
+		if !digits() || i >= len(text) || text[i] != ']' {
+			return 0
+		}
+		i++
+	}
+	if i == 0 {
+		return 0
+	}
+	for i < len(text) && text[i] == ' ' {
+		i++
+	}
+	if i >= len(text) || text[i] != '=' {
+		return 0
+	}
+	for i++; i < len(text) && text[i] == ' '; i++ {
+	}
+	return i
+}
+
+// parseArray splits a one dimensional Postgres array literal, optionally
+// with explicit bounds, into its elements, unquoting them.
+func parseArray(text string) ([]arrayToken, error) {
+	start := arrayBounds(text)
+	if !strings.HasPrefix(text[start:], "{") || !strings.HasSuffix(text, "}") {
+		return nil, fmt.Errorf("set: scan array %q: expect braces", text)
+	}
+	var tokens []arrayToken
+	i, end := start+1, len(text)-1
+	isSpace := func(c byte) bool {
+		return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\v' || c == '\f'
+	}
+	for {
+		for i < end && isSpace(text[i]) {
+			i++
+		}
+		if i == end {
+			if len(tokens) > 0 {
+				return nil, fmt.Errorf("set: scan array at byte %d: expect element", i)
+			}
+			return tokens, nil
+		}
+		token := arrayToken{pos: i}
+		switch c := text[i]; {
+		case c == '{':
+			return nil, fmt.Errorf("set: scan array at byte %d: multidimensional arrays are not supported", i)
+		case c == '"':
+			var b strings.Builder
+			for i++; ; i++ {
+				if i >= end {
+					return nil, fmt.Errorf("set: scan array at byte %d: unterminated quoted element", token.pos)
+				}
+				if text[i] == '"' {
+					i++
+					break
+				}
+				if text[i] == '\\' {
+					i++
+					if i >= end {
+						return nil, fmt.Errorf("set: scan array at byte %d: unterminated quoted element", token.pos)
+					}
+				}
+				b.WriteByte(text[i])
+			}
+			token.text = b.String()
+		default:
+			start := i
+			for i < end && text[i] != ',' && text[i] != '"' && text[i] != '{' && text[i] != '}' {
+				i++
+			}
+			token.text = strings.TrimRightFunc(text[start:i], func(r rune) bool {
+				return r < 0x80 && isSpace(byte(r))
+			})
+			token.null = strings.EqualFold(token.text, "NULL")
+		}
+		tokens = append(tokens, token)
+		for i < end && isSpace(text[i]) {
+			i++
+		}
+		switch {
+		case i == end:
+			return tokens, nil
+		case text[i] == ',':
+			i++
+		default:
+			return nil, fmt.Errorf("set: scan array at byte %d: unexpected %q", i, text[i])
+		}
+	}
+}
+
+// quoteArrayElement quotes the text of an element of a Postgres array
+// literal if it is empty, is NULL, or holds a character which would be
+// parsed as part of the array syntax.
+func quoteArrayElement(text string) string {
+	if text != "" && !strings.EqualFold(text, "NULL") && !strings.ContainsAny(text, "{}\",\\ \t\n\r\v\f") {
+		return text
+	}
+	var b strings.Builder
+	b.WriteByte('"')
+	for i := 0; i < len(text); i++ {
+		if text[i] == '"' || text[i] == '\\' {
+			b.WriteByte('\\')
+		}
+		b.WriteByte(text[i])
+	}
+	b.WriteByte('"')
+	return b.String()
+}
@@ -0,0 +1,202 @@
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package set
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+)
+
+var (
+	_ sql.Scanner   = (*String)(nil)
+	_ driver.Valuer = String(nil)
+	_ sql.Scanner   = SQL(&String{}, SQLOptions{})
+	_ driver.Valuer = SQL(&String{}, SQLOptions{})
+)
+
+func TestSet_ScanString(t *testing.T) {
+	testcases := []struct {
+		name   string
+		src    interface{}
+		expect []string
+		err    string
+	}{
+		{name: "empty array", src: "{}", expect: []string{}},
+		{name: "bytes", src: []byte("{a,b}"), expect: []string{"a", "b"}},
+		{name: "quoted", src: `{a,"b c","d,e","f\"g","h\\i",""}`, expect: []string{"a", "b c", "d,e", `f"g`, `h\i`, ""}},
+		{name: "spaces", src: ` { a , "b" ,c d } `, expect: []string{"a", "b", "c d"}},
+		{name: "quoted null", src: `{"NULL",null2}`, expect: []string{"NULL", "null2"}},
+		{name: "duplicates", src: `{a,a,"a"}`, expect: []string{"a"}},
+		{name: "json", src: `["a", "b c", "a"]`, expect: []string{"a", "b c"}},
+		{name: "bounds", src: "[1:2]={a,b}", expect: []string{"a", "b"}},
+		{name: "bounds spaces", src: "[-1:0] = {a,b}", expect: []string{"a", "b"}},
+		{name: "null element", src: "{a,NULL}", err: "unexpected null"},
+		{name: "json null element", src: `["a", null]`, err: "unexpected null"},
+		{name: "no braces", src: "a,b", err: "expect braces"},
+		{name: "multidimensional", src: "{{a},{b}}", err: "byte 1: multidimensional"},
+		{name: "unterminated", src: `{a,"b}`, err: "byte 3: unterminated"},
+		{name: "trailing comma", src: "{a,}", err: "byte 3: expect element"},
+		{name: "garbage after quote", src: `{"a"b}`, err: "byte 4: unexpected 'b'"},
+		{name: "bounds no braces", src: "[1:2]=a", err: "expect braces"},
+		{name: "bounds multidimensional", src: "[1:1][1:1]={{a}}", err: "byte 12: multidimensional"},
+		{name: "bounds trailing comma", src: "[0:1]={a,}", err: "byte 9: expect element"},
+		{name: "json numbers", src: "[1, 2]", err: "cannot unmarshal number"},
+		{name: "unsupported source", src: 42, err: "cannot scan int into String"},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		s := NewString("stale")
+		err := s.Scan(tc.src)
+		if tc.err != "" {
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Errorf("expect error containing: %s, but got: %v", tc.err, err)
+			}
+			validateSet(t, s, []string{"stale"})
+			continue
+		}
+		if err != nil {
+			t.Fatalf("expect no error, but got: %v", err)
+		}
+		validateSet(t, s, tc.expect)
+	}
+}
+
+func TestSet_ScanNumbers(t *testing.T) {
+	var i64 Int64
+	if err := i64.Scan("{1,-2,9223372036854775807}"); err != nil {
+		t.Fatalf("expect no error, but got: %v", err)
+	}
+	validateSet(t, i64, []int64{1, -2, math.MaxInt64})
+
+	var i32 Int32
+	if err := i32.Scan([]byte("[3, 4]")); err != nil {
+		t.Fatalf("expect no error, but got: %v", err)
+	}
+	validateSet(t, i32, []int32{3, 4})
+	err := i32.Scan("{1,2147483648}")
+	if err == nil || !strings.Contains(err.Error(), `"2147483648" at byte 3`) {
+		t.Errorf("expect out of range error naming the element, but got: %v", err)
+	}
+	if err := i32.Scan("{1,x}"); err == nil || !strings.Contains(err.Error(), `"x" at byte 3`) {
+		t.Errorf("expect syntax error naming the element, but got: %v", err)
+	}
+
+	var f Float64
+	if err := f.Scan("{1.5,-Infinity,NaN}"); err != nil || f.Size() != 3 || !f.Has(math.Inf(-1)) || !f.Has(1.5) {
+		t.Errorf("expect [NaN, -Inf, 1.5], but got: %v, %v", f, err)
+	}
+	var u Uint8
+	if err := u.Scan("{255,-1}"); err == nil {
+		t.Errorf("expect error on negative uint8, but got: %v", u)
+	}
+	var b Set[bool]
+	if err := b.Scan("{t,f,true}"); err != nil {
+		t.Fatalf("expect no error, but got: %v", err)
+	}
+	validateSet(t, b, []bool{true, false})
+	var i Interface
+	if err := i.Scan("{1,a}"); err != nil {
+		t.Fatalf("expect no error, but got: %v", err)
+	}
+	validateSet(t, i, []interface{}{"1", "a"})
+	var p Set[formatPoint]
+	if err := p.Scan("{1}"); err == nil {
+		t.Errorf("expect error on struct elements")
+	}
+}
+
+func TestSQL_NullPolicy(t *testing.T) {
+	testcases := []struct {
+		name   string
+		opts   SQLOptions
+		src    interface{}
+		expect Set[string]
+		err    error
+	}{
+		{name: "nil", opts: SQLOptions{}, src: nil, expect: nil},
+		{name: "json null", opts: SQLOptions{}, src: "null", expect: nil},
+		{name: "empty", opts: SQLOptions{Null: NullAsEmpty}, src: nil, expect: NewString()},
+		{name: "reject", opts: SQLOptions{Null: NullReject}, src: nil, err: ErrNull},
+		{name: "reject element", opts: SQLOptions{}, src: "{a,NULL}", err: ErrNull},
+		{name: "skip element", opts: SQLOptions{SkipNullElements: true}, src: "{a,NULL,null}", expect: NewString("a")},
+		{name: "skip json element", opts: SQLOptions{SkipNullElements: true}, src: `[null,"a"]`, expect: NewString("a")},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		s := NewString("stale")
+		err := SQL(&s, tc.opts).Scan(tc.src)
+		if tc.err != nil {
+			if !errors.Is(err, tc.err) {
+				t.Errorf("expect error: %v, but got: %v", tc.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("expect no error, but got: %v", err)
+		}
+		if (s == nil) != (tc.expect == nil) || !s.Equal(tc.expect) {
+			t.Errorf("expect %#v, but got: %#v", tc.expect, s)
+		}
+	}
+}
+
+func TestSet_Value(t *testing.T) {
+	testcases := []struct {
+		name   string
+		value  driver.Valuer
+		expect driver.Value
+	}{
+		{name: "nil", value: String(nil), expect: nil},
+		{name: "nil as empty", value: SQL(&String{}, SQLOptions{Null: NullAsEmpty}), expect: "{}"},
+		{name: "nil json", value: SQL(new(String), SQLOptions{Null: NullAsEmpty, JSON: true}), expect: "[]"},
+		{name: "empty", value: NewString(), expect: "{}"},
+		{name: "strings", value: NewString("b", "a c", "", "NULL", `d"e\f`, "g,h", "{i}"), expect: `{"","NULL","a c",b,"d\"e\\f","g,h","{i}"}`},
+		{name: "ints", value: NewInt64(3, -1, 20), expect: "{-1,3,20}"},
+		{name: "floats", value: NewFloat64(1.5, math.Inf(-1)), expect: "{-Infinity,1.5}"},
+		{name: "interface", value: NewInterface(nil, 2, "a b"), expect: `{NULL,2,"a b"}`},
+		{name: "json", value: SQL(&Set[string]{"b": {}, "a": {}}, SQLOptions{JSON: true}), expect: `["a","b"]`},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		actual, err := tc.value.Value()
+		if err != nil || actual != tc.expect {
+			t.Errorf("expect value: %v, but got: %v, %v", tc.expect, actual, err)
+		}
+	}
+	if _, err := New(formatPoint{}).Value(); err == nil {
+		t.Errorf("expect error on struct elements")
+	}
+
+	s := NewString("a", "b c", "", "NULL", `d"e\f`, "g,h", "{i}", " j ", "世界")
+	value, _ := s.Value()
+	var u String
+	if err := u.Scan(value); err != nil || !u.Equal(s) {
+		t.Errorf("expect %v, but got: %v, %v", s, u, err)
+	}
+}
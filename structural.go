@@ -0,0 +1,397 @@
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package set
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// structuralDepth bounds the nesting of the values encoded by structuralKey,
+// so that a slice or a map which holds itself is an error.
+const structuralDepth = 100
+
+// structural is the key of an element which is not hashable, it is the
+// canonical encoding of the element. It is a distinct type so that it never
+// equals a string element.
+type structural string
+
+// structuralKey returns the key of element in a StructuralSet: the element
+// itself if it is hashable, its canonical encoding otherwise.
+func structuralKey(element interface{}) (interface{}, error) {
+	if checkHashable(element) == nil {
+		return element, nil
+	}
+	var b strings.Builder
+	if err := encodeStructural(&b, reflect.ValueOf(&element).Elem(), 0); err != nil {
+		return nil, err
+	}
+	return structural(b.String()), nil
+}
+
+// encodeStructural writes the canonical encoding of v: values which are equal
+// with == have the same encoding, as have slices and maps with equal
+// elements. Interfaces are tagged with their dynamic type, maps are sorted by
+// the encoding of their keys, pointers and channels are encoded by address.
+func encodeStructural(b *strings.Builder, v reflect.Value, depth int) error {
+	if depth > structuralDepth {
+		return fmt.Errorf("set: structural encoding of %s is nested too deeply", v.Type())
+	}
+	switch v.Kind() {
+	case reflect.Interface:
+		if v.IsNil() {
+			b.WriteString("nil")
+			return nil
+		}
+		b.WriteString("(" + v.Elem().Type().String() + ")")
+		return encodeStructural(b, v.Elem(), depth+1)
+	case reflect.Bool:
+		b.WriteString(strconv.FormatBool(v.Bool()))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		b.WriteString(strconv.FormatInt(v.Int(), 10))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		b.WriteString(strconv.FormatUint(v.Uint(), 10))
+	case reflect.Float32, reflect.Float64:
+		writeStructuralFloat(b, v.Float())
+	case reflect.Complex64, reflect.Complex128:
+		writeStructuralFloat(b, real(v.Complex()))
+		b.WriteByte('+')
+		writeStructuralFloat(b, imag(v.Complex()))
+		b.WriteByte('i')
+	case reflect.String:
+		b.WriteString(strconv.Quote(v.String()))
+	case reflect.Ptr, reflect.Chan, reflect.UnsafePointer:
+		if v.IsNil() {
+			b.WriteString("nil")
+		} else {
+			b.WriteString("0x" + strconv.FormatUint(uint64(v.Pointer()), 16))
+		}
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			b.WriteString("nil")
+			return nil
+		}
+		b.WriteByte('[')
+		for i := 0; i < v.Len(); i++ {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			if err := encodeStructural(b, v.Index(i), depth+1); err != nil {
+				return err
+			}
+		}
+		b.WriteByte(']')
+	case reflect.Map:
+		if v.IsNil() {
+			b.WriteString("nil")
+			return nil
+		}
+		entries := make([]string, 0, v.Len())
+		for it := v.MapRange(); it.Next(); {
+			var entry strings.Builder
+			if err := encodeStructural(&entry, it.Key(), depth+1); err != nil {
+				return err
+			}
+			entry.WriteByte(':')
+			if err := encodeStructural(&entry, it.Value(), depth+1); err != nil {
+				return err
+			}
+			entries = append(entries, entry.String())
+		}
+		sort.Strings(entries)
+		b.WriteString("map[" + strings.Join(entries, ",") + "]")
+	case reflect.Struct:
+		b.WriteByte('{')
+		for i := 0; i < v.NumField(); i++ {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			if err := encodeStructural(b, v.Field(i), depth+1); err != nil {
+				return err
+			}
+		}
+		b.WriteByte('}')
+	default:
+		// funcs are only equal when nil, they have no structural equality
+		return &UnhashableError{Element: v.Type().String(), Type: v.Type().String()}
+	}
+	return nil
+}
+
+// writeStructuralFloat writes f so that -0 and +0 have the same encoding, and
+// so do all the NaNs.
+func writeStructuralFloat(b *strings.Builder, f float64) {
+	switch {
+	case math.IsNaN(f):
+		b.WriteString("NaN")
+	case f == 0:
+		b.WriteByte('0')
+	default:
+		b.WriteString(strconv.FormatFloat(f, 'g', -1, 64))
+	}
+}
+
+// StructuralSet is a collection of arbitrary values that contains no
+// duplicate elements, without any particular order. The hashable elements
+// are compared with == as in an Interface, the elements which are not
+// hashable, such as slices, maps, and structs or arrays holding them, are
+// compared by their structure, as reflect.DeepEqual does, instead of making
+// the set panic:
+//
+//	s, _ := set.NewStructuralSet([]int{1, 2}, map[string]int{"a": 1})
+//	s.Has([]int{1, 2}) // true
+//
+// Nil and empty slices or maps are different, pointers and channels held by
+// an unhashable element are compared by address, and NaNs held by an
+// unhashable element are equal. Funcs are not comparable and are rejected
+// with an *UnhashableError.
+// The zero value is an empty StructuralSet ready to use.
+type StructuralSet struct {
+	// m maps the key of each element to the element.
+	m map[interface{}]interface{}
+}
+
+// NewStructuralSet initializes a new StructuralSet, it returns an error if
+// an element cannot be added.
+func NewStructuralSet(elements ...interface{}) (*StructuralSet, error) {
+	s := &StructuralSet{}
+	if err := s.Add(elements...); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// MustNewStructuralSet is like NewStructuralSet but panics if an element
+// cannot be added. It is the constructor written by the %#v verb.
+func MustNewStructuralSet(elements ...interface{}) *StructuralSet {
+	s, err := NewStructuralSet(elements...)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// Add adds the elements to StructuralSet, if it is not present already. It
+// returns an error for an element which cannot be compared, such as a
+// non-nil func, the elements before it are added.
+func (s *StructuralSet) Add(elements ...interface{}) error {
+	for _, element := range elements {
+		key, err := structuralKey(element)
+		if err != nil {
+			return err
+		}
+		if s.m == nil {
+			s.m = map[interface{}]interface{}{}
+		}
+		if _, ok := s.m[key]; !ok {
+			s.m[key] = element
+		}
+	}
+	return nil
+}
+
+// Remove removes the element from StructuralSet, if it is present.
+func (s *StructuralSet) Remove(elements ...interface{}) {
+	for _, element := range elements {
+		if key, err := structuralKey(element); err == nil {
+			delete(s.m, key)
+		}
+	}
+}
+
+// Size returns the number of elements in StructuralSet.
+func (s *StructuralSet) Size() int {
+	return len(s.m)
+}
+
+// IsEmpty returns whether the StructuralSet is Empty.
+func (s *StructuralSet) IsEmpty() bool {
+	return len(s.m) == 0
+}
+
+// Clear removes all items from the StructuralSet.
+func (s *StructuralSet) Clear() {
+	s.m = nil
+}
+
+// Has judges the specified element whether exists in the StructuralSet.
+// it returns true if existed, and false if not.
+func (s *StructuralSet) Has(element interface{}) bool {
+	key, err := structuralKey(element)
+	if err != nil {
+		return false
+	}
+	_, ok := s.m[key]
+	return ok
+}
+
+// HasAll looks for the specified elements to judge
+// whether all of them exist in the StructuralSet.
+func (s *StructuralSet) HasAll(elements ...interface{}) bool {
+	for _, element := range elements {
+		if !s.Has(element) {
+			return false
+		}
+	}
+	return true
+}
+
+// HasAny looks for the specified elements to judge
+// whether at least one of them exists in the StructuralSet.
+func (s *StructuralSet) HasAny(elements ...interface{}) bool {
+	for _, element := range elements {
+		if s.Has(element) {
+			return true
+		}
+	}
+	return false
+}
+
+// List returns the all elements as a slice, in the order of String.
+func (s *StructuralSet) List() []interface{} {
+	v := make([]interface{}, 0, len(s.m))
+	for _, element := range s.m {
+		v = append(v, element)
+	}
+	sort.Slice(v, func(i, j int) bool {
+		return compareAny(v[i], v[j]) < 0
+	})
+	return v
+}
+
+// EachE traverses the elements in the StructuralSet, calling do func for
+// each StructuralSet member. the cycle will be stopped when the do func
+// returns error.
+// if err is ErrBreakEach, break the cycle and return nil,
+// else, break the cycle and return error.
+func (s *StructuralSet) EachE(do func(i interface{}) error) error {
+	for _, element := range s.m {
+		if err := do(element); err != nil {
+			if err == ErrBreakEach {
+				return nil
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// Each traverses the elements in the StructuralSet, calling do func for each
+// StructuralSet member.
+func (s *StructuralSet) Each(do func(i interface{})) {
+	for _, element := range s.m {
+		do(element)
+	}
+}
+
+// combine returns a new StructuralSet which is op of StructuralSet s and t.
+func (s *StructuralSet) combine(t *StructuralSet, op int) *StructuralSet {
+	u := &StructuralSet{m: map[interface{}]interface{}{}}
+	for key, element := range s.m {
+		_, ok := t.m[key]
+		if ok == (op == opAnd) || op == opOr {
+			u.m[key] = element
+		}
+	}
+	if op == opOr || op == opXor {
+		for key, element := range t.m {
+			if _, ok := s.m[key]; !ok {
+				u.m[key] = element
+			}
+		}
+	}
+	return u
+}
+
+// Union returns the union of StructuralSet s and t.
+func (s *StructuralSet) Union(t *StructuralSet) *StructuralSet {
+	return s.combine(t, opOr)
+}
+
+// Difference returns the difference of StructuralSet s and t.
+func (s *StructuralSet) Difference(t *StructuralSet) *StructuralSet {
+	return s.combine(t, opAndNot)
+}
+
+// Intersection returns the intersection of StructuralSet s and t.
+func (s *StructuralSet) Intersection(t *StructuralSet) *StructuralSet {
+	return s.combine(t, opAnd)
+}
+
+// SymmetricDifference returns a new StructuralSet with the elements that are either in this StructuralSet
+// or in the given StructuralSet, but not in both.
+func (s *StructuralSet) SymmetricDifference(t *StructuralSet) *StructuralSet {
+	return s.combine(t, opXor)
+}
+
+// IsSubset predicates that tests whether the StructuralSet s is a subset of StructuralSet t.
+func (s *StructuralSet) IsSubset(t *StructuralSet) bool {
+	if len(s.m) > len(t.m) {
+		return false
+	}
+	for key := range s.m {
+		if _, ok := t.m[key]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSuperset predicates that tests whether the StructuralSet s is a super of StructuralSet t.
+func (s *StructuralSet) IsSuperset(t *StructuralSet) bool {
+	return t.IsSubset(s)
+}
+
+// Equal predicates that tests whether the StructuralSet s equals of StructuralSet t.
+func (s *StructuralSet) Equal(t *StructuralSet) bool {
+	return len(s.m) == len(t.m) && s.IsSubset(t)
+}
+
+// Copy returns new StructuralSet that clones from StructuralSet, the
+// elements are shared and not copied.
+func (s *StructuralSet) Copy() *StructuralSet {
+	u := &StructuralSet{m: make(map[interface{}]interface{}, len(s.m))}
+	for key, element := range s.m {
+		u.m[key] = element
+	}
+	return u
+}
+
+// String returns a string representation of StructuralSet, the elements are
+// ordered by their type name and then by their Go-syntax representation.
+func (s *StructuralSet) String() string {
+	return joinElements(s.List(), "%v")
+}
+
+// Format implements fmt.Formatter, the elements are in the order of String.
+// See Set.Format for the verbs, %#v calls MustNewStructuralSet since
+// NewStructuralSet also returns an error.
+func (s *StructuralSet) Format(f fmt.State, verb rune) {
+	formatElements(f, verb, s.List(), "MustNewStructuralSet")
+}
@@ -0,0 +1,172 @@
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package set
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestStructuralSet(t *testing.T) {
+	x := 1
+	testcases := []struct {
+		name  string
+		a, b  interface{}
+		equal bool
+	}{
+		{name: "hashable", a: 1, b: 1, equal: true},
+		{name: "hashable types differ", a: 1, b: int64(1), equal: false},
+		{name: "slices", a: []int{1, 2}, b: []int{1, 2}, equal: true},
+		{name: "slices differ", a: []int{1, 2}, b: []int{2, 1}, equal: false},
+		{name: "slice types differ", a: []int{1}, b: []int64{1}, equal: false},
+		{name: "nil and empty slices", a: []int(nil), b: []int{}, equal: false},
+		{name: "maps", a: map[string]int{"a": 1, "b": 2}, b: map[string]int{"b": 2, "a": 1}, equal: true},
+		{name: "maps differ", a: map[string]int{"a": 1}, b: map[string]int{"a": 2}, equal: false},
+		{name: "nested slices", a: [][]string{{"a"}, {}}, b: [][]string{{"a"}, {}}, equal: true},
+		{name: "struct with slice", a: tagged{Name: "a", Tags: []string{"x"}}, b: tagged{Name: "a", Tags: []string{"x"}}, equal: true},
+		{name: "struct with slice differ", a: tagged{Name: "a", Tags: []string{"x"}}, b: tagged{Name: "a", Tags: []string{"y"}}, equal: false},
+		{
+			name:  "array of interfaces",
+			a:     [2]interface{}{1, []interface{}{"a", 1.5}},
+			b:     [2]interface{}{1, []interface{}{"a", 1.5}},
+			equal: true,
+		},
+		{
+			name:  "dynamic types in interfaces differ",
+			a:     []interface{}{1},
+			b:     []interface{}{int8(1)},
+			equal: false,
+		},
+		{
+			name:  "nested struct in array in struct",
+			a:     nested{ID: 1, Inner: [2]interface{}{tagged{Tags: []string{}}, nil}},
+			b:     nested{ID: 1, Inner: [2]interface{}{tagged{Tags: []string{}}, nil}},
+			equal: true,
+		},
+		{name: "zeros", a: []float64{0}, b: []float64{math.Copysign(0, -1)}, equal: true},
+		{name: "NaN", a: []float64{math.NaN()}, b: []float64{math.NaN()}, equal: true},
+		{name: "pointers by address", a: []*int{&x}, b: []*int{&x}, equal: true},
+		{name: "pointers to equal values", a: []*int{&x}, b: []*int{new(int)}, equal: false},
+		{name: "strings and slices", a: "[1]", b: []int{1}, equal: false},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		s, err := NewStructuralSet(tc.a)
+		if err != nil {
+			t.Fatalf("expect no error, but got: %v", err)
+		}
+		if !s.Has(tc.a) {
+			t.Errorf("expect %v in %v", tc.a, s)
+		}
+		if got := s.Has(tc.b); got != tc.equal {
+			t.Errorf("expect has %v: %v, but got: %v", tc.b, tc.equal, got)
+		}
+		if err := s.Add(tc.b); err != nil {
+			t.Fatalf("expect no error, but got: %v", err)
+		}
+		expect := 2
+		if tc.equal {
+			expect = 1
+		}
+		if s.Size() != expect {
+			t.Errorf("expect size: %d, but got: %d", expect, s.Size())
+		}
+		s.Remove(tc.b)
+		if s.Has(tc.b) {
+			t.Errorf("expect %v removed", tc.b)
+		}
+	}
+}
+
+func TestStructuralSet_Errors(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expect MustNewStructuralSet to panic on a func")
+		}
+	}()
+	_, err := NewStructuralSet(1, func() {})
+	if !errors.Is(err, ErrUnhashable) {
+		t.Errorf("expect error: %v, but got: %v", ErrUnhashable, err)
+	}
+
+	var s StructuralSet
+	err = s.Add([]int{1}, tagged{}, []interface{}{func() {}}, 2)
+	if !errors.Is(err, ErrUnhashable) || s.Size() != 2 {
+		t.Errorf("expect error after 2 elements, but got: %v, %v", err, &s)
+	}
+	if s.Has([]interface{}{func() {}}) {
+		t.Errorf("expect funcs to never be present")
+	}
+
+	cyclic := []interface{}{nil}
+	cyclic[0] = cyclic
+	if err := s.Add(cyclic); err == nil || !strings.Contains(err.Error(), "nested too deeply") {
+		t.Errorf("expect nesting error, but got: %v", err)
+	}
+	MustNewStructuralSet(1, func() {})
+}
+
+func TestStructuralSet_Operations(t *testing.T) {
+	s, _ := NewStructuralSet([]int{1}, []int{2}, "a")
+	u, _ := NewStructuralSet([]int{2}, []int{3}, "a")
+	testcases := []struct {
+		name   string
+		got    *StructuralSet
+		expect []interface{}
+	}{
+		{name: "union", got: s.Union(u), expect: []interface{}{[]int{1}, []int{2}, []int{3}, "a"}},
+		{name: "intersection", got: s.Intersection(u), expect: []interface{}{[]int{2}, "a"}},
+		{name: "difference", got: s.Difference(u), expect: []interface{}{[]int{1}}},
+		{name: "symmetric difference", got: s.SymmetricDifference(u), expect: []interface{}{[]int{1}, []int{3}}},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		expect, _ := NewStructuralSet(tc.expect...)
+		if !tc.got.Equal(expect) {
+			t.Errorf("expect set: %v, but got: %v", expect, tc.got)
+		}
+	}
+	if !s.Intersection(u).IsSubset(s) || !s.IsSuperset(s.Difference(u)) || s.IsSubset(u) {
+		t.Errorf("expect subset relations of %v and %v", s, u)
+	}
+	c := s.Copy()
+	c.Clear()
+	if s.Size() != 3 || !c.IsEmpty() {
+		t.Errorf("expect the copy to be independent, but got: %v", s)
+	}
+	if got := s.String(); got != "[[1], [2], a]" {
+		t.Errorf("expect string: [[1], [2], a], but got: %s", got)
+	}
+	found := 0
+	_ = s.EachE(func(i interface{}) error {
+		found++
+		return ErrBreakEach
+	})
+	if found != 1 {
+		t.Errorf("expect EachE to stop after: 1 element, but got: %d", found)
+	}
+}
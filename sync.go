@@ -0,0 +1,428 @@
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package set
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Sync is a Set which is safe for concurrent use by multiple goroutines.
+// Reads are guarded by a read lock and writes by a write lock of a
+// sync.RWMutex. The zero value is an empty Sync ready to use.
+// A Sync must not be copied after first use.
+type Sync[T comparable] struct {
+	mu sync.RWMutex
+	s  Set[T]
+}
+
+// NewSync initializes a new Sync.
+func NewSync[T comparable](elements ...T) *Sync[T] {
+	return &Sync[T]{s: New(elements...)}
+}
+
+// NewSyncWithSize initializes a new Sync with the specified size.
+func NewSyncWithSize[T comparable](size int) *Sync[T] {
+	return &Sync[T]{s: NewWithSize[T](size)}
+}
+
+// set returns the underlying Set, initializing it if necessary.
+// the caller must hold the write lock.
+func (s *Sync[T]) set() Set[T] {
+	if s.s == nil {
+		s.s = New[T]()
+	}
+	return s.s
+}
+
+// Add adds the elements to Sync, if it is not present already.
+func (s *Sync[T]) Add(elements ...T) {
+	s.mu.Lock()
+	s.set().Add(elements...)
+	s.mu.Unlock()
+}
+
+// AddIfAbsent adds the element to Sync if it is not present already.
+// it returns true if the element was added, and false if it existed.
+// the check and the addition happen atomically.
+func (s *Sync[T]) AddIfAbsent(element T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.s.Has(element) {
+		return false
+	}
+	s.set()[element] = struct{}{}
+	return true
+}
+
+// Remove removes the element from Sync, if it is present.
+func (s *Sync[T]) Remove(elements ...T) {
+	s.mu.Lock()
+	s.s.Remove(elements...)
+	s.mu.Unlock()
+}
+
+// Pop returns an arbitrary element of Sync, deleting it from Sync.
+// The second value is a bool that is true if the elements existed in
+// the Sync, and false if not.
+func (s *Sync[T]) Pop() (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.s.Pop()
+}
+
+// PopN removes up to n arbitrary elements from Sync and returns them,
+// the elements are removed atomically.
+func (s *Sync[T]) PopN(n int) []T {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if n > len(s.s) {
+		n = len(s.s)
+	}
+	if n <= 0 {
+		return nil
+	}
+	dest := make([]T, 0, n)
+	for k := range s.s {
+		if len(dest) == n {
+			break
+		}
+		delete(s.s, k)
+		dest = append(dest, k)
+	}
+	return dest
+}
+
+// Size returns the number of elements in Sync.
+func (s *Sync[T]) Size() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.s)
+}
+
+// IsEmpty returns whether the Sync is Empty.
+func (s *Sync[T]) IsEmpty() bool {
+	return s.Size() == 0
+}
+
+// Clear removes all items from the Sync.
+func (s *Sync[T]) Clear() {
+	s.mu.Lock()
+	s.s = New[T]()
+	s.mu.Unlock()
+}
+
+// Has judges the specified element whether exists in the Sync.
+// it returns true if existed, and false if not.
+func (s *Sync[T]) Has(element T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.s.Has(element)
+}
+
+// HasAll looks for the specified elements to judge
+// whether all exist in the Sync.
+// it returns true if existed, and false if not.
+func (s *Sync[T]) HasAll(elements ...T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.s.HasAll(elements...)
+}
+
+// HasAny looks for the specified elements to judge
+// whether at least one of the element exists in the Sync.
+// it returns true if existed, and false if not.
+func (s *Sync[T]) HasAny(elements ...T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.s.HasAny(elements...)
+}
+
+// Snapshot returns a Set holding the elements of Sync at one point in time,
+// later changes of Sync are not reflected in it.
+func (s *Sync[T]) Snapshot() Set[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.s.Copy()
+}
+
+// List returns the all elements as a slice, taken at one point in time.
+func (s *Sync[T]) List() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.s.List()
+}
+
+// SortedList returns the all elements as a slice sorted by less func.
+func (s *Sync[T]) SortedList(less func(i, j T) bool) []T {
+	return s.Snapshot().SortedList(less)
+}
+
+// EachE traverses a snapshot of the elements in the Sync, calling do func
+// for each member. the lock is not held while do runs, so do may modify
+// the Sync, those changes are not visible to the traversal.
+// the cycle will be stopped when the do func returns error.
+// if err is ErrBreakEach, break the cycle and return nil,
+// else, break the cycle and return error.
+func (s *Sync[T]) EachE(do func(i T) error) error {
+	return s.Snapshot().EachE(do)
+}
+
+// Each traverses a snapshot of the elements in the Sync, calling do func
+// for each member. the lock is not held while do runs, so do may modify
+// the Sync, those changes are not visible to the traversal.
+func (s *Sync[T]) Each(do func(i T)) {
+	s.Snapshot().Each(do)
+}
+
+// Filter returns a new Sync with the elements of Sync s for which pred
+// returns true. pred runs on a snapshot without holding the lock, as for Each.
+func (s *Sync[T]) Filter(pred func(i T) bool) *Sync[T] {
+	return &Sync[T]{s: s.Snapshot().Filter(pred)}
+}
+
+// Partition returns two new Syncs, the first with the elements of Sync s for
+// which pred returns true, the second with the others. pred runs on a
+// snapshot without holding the lock, as for Each.
+func (s *Sync[T]) Partition(pred func(i T) bool) (*Sync[T], *Sync[T]) {
+	in, out := s.Snapshot().Partition(pred)
+	return &Sync[T]{s: in}, &Sync[T]{s: out}
+}
+
+// Any reports whether pred returns true for any element of a snapshot of
+// the Sync, the traversal stops at the first such element.
+func (s *Sync[T]) Any(pred func(i T) bool) bool {
+	return s.Snapshot().Any(pred)
+}
+
+// Every reports whether pred returns true for every element of a snapshot
+// of the Sync, the traversal stops at the first element for which pred
+// returns false.
+func (s *Sync[T]) Every(pred func(i T) bool) bool {
+	return s.Snapshot().Every(pred)
+}
+
+// Find returns an arbitrary element of a snapshot of the Sync for which pred
+// returns true. The second value is false if there is no such element.
+func (s *Sync[T]) Find(pred func(i T) bool) (T, bool) {
+	return s.Snapshot().Find(pred)
+}
+
+// Count returns the number of elements of a snapshot of the Sync for which
+// pred returns true.
+func (s *Sync[T]) Count(pred func(i T) bool) int {
+	return s.Snapshot().Count(pred)
+}
+
+// Union returns the union of Sync s and t.
+func (s *Sync[T]) Union(t *Sync[T]) *Sync[T] {
+	// snapshot t before locking s, holding both locks at once could
+	// deadlock against a concurrent t.Union(s)
+	u := t.Snapshot()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	u.UnionWith(s.s)
+	return &Sync[T]{s: u}
+}
+
+// Difference returns the difference of Sync s and t.
+func (s *Sync[T]) Difference(t *Sync[T]) *Sync[T] {
+	u := t.Snapshot()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return &Sync[T]{s: s.s.Difference(u)}
+}
+
+// Intersection returns the intersection of Sync s and t.
+func (s *Sync[T]) Intersection(t *Sync[T]) *Sync[T] {
+	u := t.Snapshot()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	u.IntersectWith(s.s)
+	return &Sync[T]{s: u}
+}
+
+// SymmetricDifference returns a new Sync with the elements that are either in this Sync
+// or in the given Sync, but not in both.
+func (s *Sync[T]) SymmetricDifference(t *Sync[T]) *Sync[T] {
+	u := t.Snapshot()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	u.SymmetricDifferenceWith(s.s)
+	return &Sync[T]{s: u}
+}
+
+// UnionWith adds all elements of Sync t to Sync s atomically.
+func (s *Sync[T]) UnionWith(t *Sync[T]) {
+	u := t.Snapshot()
+	s.mu.Lock()
+	s.set().UnionWith(u)
+	s.mu.Unlock()
+}
+
+// DifferenceWith removes all elements of Sync t from Sync s atomically.
+func (s *Sync[T]) DifferenceWith(t *Sync[T]) {
+	u := t.Snapshot()
+	s.mu.Lock()
+	s.s.DifferenceWith(u)
+	s.mu.Unlock()
+}
+
+// IntersectWith removes the elements of Sync s which are not in Sync t atomically.
+func (s *Sync[T]) IntersectWith(t *Sync[T]) {
+	u := t.Snapshot()
+	s.mu.Lock()
+	s.s.IntersectWith(u)
+	s.mu.Unlock()
+}
+
+// SymmetricDifferenceWith keeps the elements that are either in Sync s or in
+// Sync t, but not in both, atomically.
+func (s *Sync[T]) SymmetricDifferenceWith(t *Sync[T]) {
+	u := t.Snapshot()
+	s.mu.Lock()
+	s.set().SymmetricDifferenceWith(u)
+	s.mu.Unlock()
+}
+
+// IsSubset predicates that tests whether the Sync s is a subset of Sync t.
+func (s *Sync[T]) IsSubset(t *Sync[T]) bool {
+	u := t.Snapshot()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.s.IsSubset(u)
+}
+
+// IsSuperset predicates that tests whether the Sync s is a super of Sync t.
+func (s *Sync[T]) IsSuperset(t *Sync[T]) bool {
+	u := t.Snapshot()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return u.IsSubset(s.s)
+}
+
+// Equal predicates that tests whether the Sync s equals of Sync t.
+func (s *Sync[T]) Equal(t *Sync[T]) bool {
+	u := t.Snapshot()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.s.Equal(u)
+}
+
+// Copy returns new Sync that clones from Sync.
+func (s *Sync[T]) Copy() *Sync[T] {
+	return &Sync[T]{s: s.Snapshot()}
+}
+
+// String returns a string representation of Sync
+func (s *Sync[T]) String() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.s.String()
+}
+
+// Format implements fmt.Formatter, it formats a snapshot of the Sync, the
+// elements are in their natural order. See Set.Format for the verbs.
+func (s *Sync[T]) Format(f fmt.State, verb rune) {
+	v := s.Snapshot().List()
+	sortElements(v)
+	formatElements(f, verb, v, "NewSync["+typeName[T]()+"]")
+}
+
+// MarshalJSON implements json.Marshaler, it encodes a snapshot of the Sync
+// as Set.MarshalJSON does.
+func (s *Sync[T]) MarshalJSON() ([]byte, error) {
+	return s.Snapshot().MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler, it replaces the Sync with the
+// elements of a JSON array as Set.UnmarshalJSON does.
+func (s *Sync[T]) UnmarshalJSON(data []byte) error {
+	var u Set[T]
+	if err := u.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	s.s = u
+	s.mu.Unlock()
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler, it encodes a snapshot of the Sync
+// as Set.MarshalYAML does.
+func (s *Sync[T]) MarshalYAML() (interface{}, error) {
+	return s.Snapshot().MarshalYAML()
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, it replaces the Sync with the
+// elements of a sequence as Set.UnmarshalYAML does.
+func (s *Sync[T]) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var u Set[T]
+	if err := u.UnmarshalYAML(unmarshal); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	s.s = u
+	s.mu.Unlock()
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, it encodes a snapshot of
+// the Sync as Set.MarshalBinary does.
+func (s *Sync[T]) MarshalBinary() ([]byte, error) {
+	return s.Snapshot().MarshalBinary()
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, it replaces the Sync
+// with the decoded elements as Set.UnmarshalBinary does.
+func (s *Sync[T]) UnmarshalBinary(data []byte) error {
+	var u Set[T]
+	if err := u.UnmarshalBinary(data); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	s.s = u
+	s.mu.Unlock()
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder, it encodes a snapshot of the Sync as
+// Set.GobEncode does.
+func (s *Sync[T]) GobEncode() ([]byte, error) {
+	return s.Snapshot().GobEncode()
+}
+
+// GobDecode implements gob.GobDecoder, it replaces the Sync with the decoded
+// elements as Set.GobDecode does.
+func (s *Sync[T]) GobDecode(data []byte) error {
+	var u Set[T]
+	if err := u.GobDecode(data); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	s.s = u
+	s.mu.Unlock()
+	return nil
+}
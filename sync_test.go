@@ -0,0 +1,220 @@
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package set
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+)
+
+func TestSync(t *testing.T) {
+	var s Sync[int]
+	if !s.IsEmpty() {
+		t.Errorf("expect zero Sync to be empty, but got: %s", s.String())
+	}
+	s.Add(1, 2, 3)
+	s.Remove(3)
+	validateSet(t, s.Snapshot(), []int{1, 2})
+	if !s.Has(1) || s.Has(3) || !s.HasAll(1, 2) || !s.HasAny(3, 2) || s.Size() != 2 {
+		t.Errorf("unexpected predicates on: %s", s.String())
+	}
+	if s.AddIfAbsent(1) {
+		t.Errorf("expect AddIfAbsent to return false for existed element")
+	}
+	if !s.AddIfAbsent(4) {
+		t.Errorf("expect AddIfAbsent to return true for absent element")
+	}
+	sorted := s.SortedList(func(i, j int) bool { return i < j })
+	if len(sorted) != 3 || sorted[0] != 1 || sorted[2] != 4 {
+		t.Errorf("expect sorted list: [1 2 4], but got: %v", sorted)
+	}
+	validateSet(t, New(s.List()...), []int{1, 2, 4})
+	c := s.Copy()
+	c.Add(9)
+	if s.Has(9) {
+		t.Errorf("expect copy to be independent of origin set")
+	}
+	if k, ok := s.Pop(); !ok || s.Has(k) {
+		t.Errorf("expect Pop to remove an element, but got: %v, %v", k, ok)
+	}
+	s.Clear()
+	if _, ok := s.Pop(); ok || s.Size() != 0 {
+		t.Errorf("expect empty set after Clear, but got: %s", s.String())
+	}
+}
+
+func TestSync_PopN(t *testing.T) {
+	testcases := []struct {
+		name       string
+		s          []int
+		n          int
+		expectLen  int
+		expectLeft int
+	}{
+		{name: "n is zero", s: []int{1, 2, 3}, n: 0, expectLen: 0, expectLeft: 3},
+		{name: "n is negative", s: []int{1, 2, 3}, n: -1, expectLen: 0, expectLeft: 3},
+		{name: "n is less than size", s: []int{1, 2, 3}, n: 2, expectLen: 2, expectLeft: 1},
+		{name: "n is larger than size", s: []int{1, 2, 3}, n: 5, expectLen: 3, expectLeft: 0},
+		{name: "s is empty", s: []int{}, n: 5, expectLen: 0, expectLeft: 0},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		s := NewSync(tc.s...)
+		actual := s.PopN(tc.n)
+		if len(actual) != tc.expectLen || s.Size() != tc.expectLeft {
+			t.Errorf("expect pop %d and left %d, but got: %v and %s", tc.expectLen, tc.expectLeft, actual, s.String())
+		}
+		if s.HasAny(actual...) {
+			t.Errorf("expect popped elements to be removed, but got: %s", s.String())
+		}
+	}
+}
+
+func TestSync_Algebra(t *testing.T) {
+	s, u := NewSync(1, 2, 3), NewSync(3, 4)
+	validateSet(t, s.Union(u).Snapshot(), []int{1, 2, 3, 4})
+	validateSet(t, s.Difference(u).Snapshot(), []int{1, 2})
+	validateSet(t, s.Intersection(u).Snapshot(), []int{3})
+	validateSet(t, s.SymmetricDifference(u).Snapshot(), []int{1, 2, 4})
+	if s.IsSubset(u) || s.IsSuperset(u) || s.Equal(u) || !s.Equal(s.Copy()) {
+		t.Errorf("unexpected predicates on: %s and %s", s.String(), u.String())
+	}
+
+	w := s.Copy()
+	w.UnionWith(u)
+	validateSet(t, w.Snapshot(), []int{1, 2, 3, 4})
+	w.DifferenceWith(u)
+	validateSet(t, w.Snapshot(), []int{1, 2})
+	w.SymmetricDifferenceWith(u)
+	validateSet(t, w.Snapshot(), []int{1, 2, 3, 4})
+	w.IntersectWith(s)
+	validateSet(t, w.Snapshot(), []int{1, 2, 3})
+	w.UnionWith(w)
+	validateSet(t, w.Snapshot(), []int{1, 2, 3})
+}
+
+func TestSync_Each(t *testing.T) {
+	s := NewSync(1, 2, 3)
+	var visited []int
+	// do may modify the set, the traversal works on a snapshot.
+	s.Each(func(i int) {
+		visited = append(visited, i)
+		s.Remove(i)
+		s.Add(i + 10)
+	})
+	sort.Ints(visited)
+	if len(visited) != 3 || visited[0] != 1 || visited[2] != 3 {
+		t.Errorf("expect visited: [1 2 3], but got: %v", visited)
+	}
+	validateSet(t, s.Snapshot(), []int{11, 12, 13})
+
+	inputErr := errors.New("s error")
+	if err := s.EachE(func(i int) error { return inputErr }); err != inputErr {
+		t.Errorf("expect error: %v, but got: %v", inputErr, err)
+	}
+	if err := s.EachE(func(i int) error { return ErrBreakEach }); err != nil {
+		t.Errorf("expect no error, but got: %v", err)
+	}
+}
+
+func TestSync_JSON(t *testing.T) {
+	s := NewSync("b", "a")
+	data, err := json.Marshal(s)
+	if err != nil || string(data) != `["a","b"]` {
+		t.Fatalf("expect json: [\"a\",\"b\"], but got: %s, %v", data, err)
+	}
+	var u Sync[string]
+	if err := json.Unmarshal(data, &u); err != nil {
+		t.Fatalf("expect no error, but got: %v", err)
+	}
+	if !u.Equal(s) {
+		t.Errorf("expect %s, but got: %s", s.String(), u.String())
+	}
+}
+
+func TestSync_Concurrent(t *testing.T) {
+	const workers, elements = 8, 1000
+	s, u := NewSync[int](), NewSync[int]()
+	added := make([]int, workers)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < elements; i++ {
+				if s.AddIfAbsent(i) {
+					added[w]++
+				}
+				u.Add(i % 10)
+				s.Has(i)
+				s.Size()
+				if i%100 == 0 {
+					_ = s.Union(u)
+					_ = u.Union(s)
+					u.UnionWith(s)
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+	total := 0
+	for _, n := range added {
+		total += n
+	}
+	if total != elements {
+		t.Errorf("expect AddIfAbsent to succeed %d times, but got: %d", elements, total)
+	}
+
+	popped := make([][]int, workers)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for {
+				v := s.PopN(7)
+				if len(v) == 0 {
+					return
+				}
+				popped[w] = append(popped[w], v...)
+			}
+		}(w)
+	}
+	wg.Wait()
+	seen := New[int]()
+	for _, v := range popped {
+		for _, i := range v {
+			if seen.Has(i) {
+				t.Errorf("expect element %d to be popped once", i)
+			}
+			seen.Add(i)
+		}
+	}
+	if seen.Size() != elements || !s.IsEmpty() {
+		t.Errorf("expect all %d elements popped, but got: %d", elements, seen.Size())
+	}
+}
@@ -0,0 +1,272 @@
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package set
+
+import (
+	"flag"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Set adds the comma separated elements of value to the Set, it is the Set
+// method of the flag.Value returned by Flag. The elements are parsed as
+// UnmarshalText does, the Set is left unchanged on error.
+// The Set itself is not meant to be given to flag.Var: its String is the
+// bracketed form of fmt, such as [a, b], which Set cannot parse back.
+func (s *Set[T]) Set(value string) error {
+	v, err := parseText[T](value)
+	if err != nil {
+		return err
+	}
+	if *s == nil {
+		*s = NewWithSize[T](len(v))
+	}
+	s.Add(v...)
+	return nil
+}
+
+// Flag returns a flag.Value backed by s, so that a flag may be given a
+// list, repeated, or both. Its Set adds the comma separated elements of value
+// as (*Set).Set does, and its String returns the elements in the form of
+// MarshalText, so that a default value printed by the flag package may be
+// given back to the flag:
+//
+//	tags := set.NewString("a", "b,c")
+//	flag.Var(set.Flag(&tags), "tag", "comma separated tags, may be repeated")
+//	// -help prints (default a,"b,c"), -tag d -tag e,f adds d, e and f
+func Flag[T comparable](s *Set[T]) flag.Value {
+	return &setFlag[T]{s: s}
+}
+
+// setFlag is the flag.Value returned by Flag.
+type setFlag[T comparable] struct {
+	s *Set[T]
+}
+
+// String implements flag.Value, it returns the elements separated by commas
+// in their natural order, as MarshalText does.
+func (f *setFlag[T]) String() string {
+	if f == nil || f.s == nil {
+		return ""
+	}
+	text, err := f.s.MarshalText()
+	if err != nil {
+		return f.s.String()
+	}
+	return string(text)
+}
+
+// Set implements flag.Value, it adds the comma separated elements of value
+// to the Set.
+func (f *setFlag[T]) Set(value string) error {
+	return f.s.Set(value)
+}
+
+// MarshalText implements encoding.TextMarshaler, it encodes the Set as its
+// elements separated by commas in their natural order, such as a,b,c.
+// Strings which are empty, hold a comma or a quote, start or end with a
+// space, or are not printable are quoted with Go syntax, such as "b,c".
+// An Interface encodes its elements by their dynamic type, and decodes them
+// as strings.
+func (s Set[T]) MarshalText() ([]byte, error) {
+	v := s.List()
+	sortElements(v)
+	var b strings.Builder
+	for i, element := range v {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		text, err := formatElement(element)
+		if err != nil {
+			return nil, err
+		}
+		if reflect.ValueOf(element).Kind() == reflect.String {
+			text = quoteText(text)
+		}
+		b.WriteString(text)
+	}
+	return []byte(b.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, it replaces the Set
+// with the comma separated elements of text. Spaces around the elements are
+// trimmed, and an element may be quoted with Go syntax to hold a comma.
+// An error names the element which cannot be parsed and its byte offset in
+// text, the Set is left unchanged on error.
+func (s *Set[T]) UnmarshalText(text []byte) error {
+	v, err := parseText[T](string(text))
+	if err != nil {
+		return err
+	}
+	u := NewWithSize[T](len(v))
+	u.Add(v...)
+	*s = u
+	return nil
+}
+
+// parseText returns the comma separated elements of text, an empty or
+// blank text holds no element.
+func parseText[T comparable](text string) ([]T, error) {
+	if strings.TrimSpace(text) == "" {
+		return nil, nil
+	}
+	var v []T
+	for i := 0; ; {
+		for i < len(text) && text[i] == ' ' {
+			i++
+		}
+		start := i
+		var token string
+		if i < len(text) && text[i] == '"' {
+			for i++; i < len(text) && text[i] != '"'; i++ {
+				if text[i] == '\\' {
+					i++
+				}
+			}
+			if i >= len(text) {
+				return nil, fmt.Errorf("set: parse %q at byte %d: unterminated quote", text[start:], start)
+			}
+			i++
+			unquoted, err := strconv.Unquote(text[start:i])
+			if err != nil {
+				return nil, fmt.Errorf("set: parse %q at byte %d: %w", text[start:i], start, err)
+			}
+			token = unquoted
+		} else {
+			for i < len(text) && text[i] != ',' {
+				i++
+			}
+			token = strings.TrimRight(text[start:i], " ")
+			if token == "" {
+				return nil, fmt.Errorf("set: parse empty element at byte %d", start)
+			}
+		}
+		element, err := parseElement[T](token)
+		if err != nil {
+			return nil, fmt.Errorf("set: parse %q at byte %d: %w", token, start, err)
+		}
+		v = append(v, element)
+		for i < len(text) && text[i] == ' ' {
+			i++
+		}
+		switch {
+		case i == len(text):
+			return v, nil
+		case text[i] != ',':
+			return nil, fmt.Errorf("set: parse %q at byte %d: expect a comma after a quoted element", text[i:], i)
+		}
+		i++
+	}
+}
+
+// quoteText quotes a string element of MarshalText if it would not be
+// parsed back as is.
+func quoteText(text string) string {
+	if text == "" || strings.ContainsAny(text, `,"`) || strings.HasPrefix(text, " ") || strings.HasSuffix(text, " ") {
+		return strconv.Quote(text)
+	}
+	for _, r := range text {
+		if r == utf8.RuneError || !unicode.IsPrint(r) {
+			return strconv.Quote(text)
+		}
+	}
+	return text
+}
+
+// parseElement converts the text of an element to T, which is a bool, a
+// number or a string type, or interface{} which holds the text as a string.
+func parseElement[T comparable](text string) (T, error) {
+	var element T
+	v := reflect.ValueOf(&element).Elem()
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(text)
+	case reflect.Bool:
+		x, err := strconv.ParseBool(text)
+		if err != nil {
+			return element, err
+		}
+		v.SetBool(x)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		x, err := strconv.ParseInt(text, 10, v.Type().Bits())
+		if err != nil {
+			return element, err
+		}
+		v.SetInt(x)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		x, err := strconv.ParseUint(text, 10, v.Type().Bits())
+		if err != nil {
+			return element, err
+		}
+		v.SetUint(x)
+	case reflect.Float32, reflect.Float64:
+		x, err := strconv.ParseFloat(text, v.Type().Bits())
+		if err != nil {
+			return element, err
+		}
+		v.SetFloat(x)
+	case reflect.Interface:
+		if v.NumMethod() != 0 {
+			return element, fmt.Errorf("cannot parse %s elements", typeName[T]())
+		}
+		v.Set(reflect.ValueOf(text))
+	default:
+		return element, fmt.Errorf("cannot parse %s elements", typeName[T]())
+	}
+	return element, nil
+}
+
+// formatElement returns the text of an element, which is parsed back by
+// parseElement. Elements held by an interface are formatted by their
+// dynamic type.
+func formatElement(element interface{}) (string, error) {
+	v := reflect.ValueOf(element)
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		switch f := v.Float(); {
+		case math.IsInf(f, 1):
+			return "Infinity", nil
+		case math.IsInf(f, -1):
+			return "-Infinity", nil
+		default:
+			return strconv.FormatFloat(f, 'g', -1, v.Type().Bits()), nil
+		}
+	default:
+		return "", fmt.Errorf("set: cannot format element of type %T", element)
+	}
+}
@@ -0,0 +1,215 @@
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package set
+
+import (
+	"encoding"
+	"flag"
+	"math"
+	"strings"
+	"testing"
+)
+
+var (
+	_ encoding.TextMarshaler   = String(nil)
+	_ encoding.TextUnmarshaler = (*Float64)(nil)
+)
+
+func TestSet_Flag(t *testing.T) {
+	var tags String
+	var ports Int
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.Var(Flag(&tags), "tag", "comma separated tags")
+	fs.Var(Flag(&ports), "port", "comma separated ports")
+	err := fs.Parse([]string{"-tag", "a,b", "-port", "80, 443", "-tag", `c,"d,e",a`, "-port", "80"})
+	if err != nil {
+		t.Fatalf("expect no error, but got: %v", err)
+	}
+	validateSet(t, tags, []string{"a", "b", "c", "d,e"})
+	validateSet(t, ports, []int{80, 443})
+
+	fs = flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(new(strings.Builder))
+	fs.Var(Flag(&ports), "port", "comma separated ports")
+	err = fs.Parse([]string{"-port", "8080,http"})
+	if err == nil || !strings.Contains(err.Error(), `parse "http" at byte 5`) {
+		t.Errorf("expect error naming the token, but got: %v", err)
+	}
+	validateSet(t, ports, []int{80, 443})
+}
+
+func TestFlag(t *testing.T) {
+	tags := NewString("b,c", "a", "", " d")
+	f := Flag(&tags)
+	if got := f.String(); got != `""," d",a,"b,c"` {
+		t.Errorf("expect flag string: %s, but got: %s", `""," d",a,"b,c"`, got)
+	}
+
+	var back String
+	if err := Flag(&back).Set(f.String()); err != nil {
+		t.Fatalf("expect no error, but got: %v", err)
+	}
+	if !back.Equal(tags) {
+		t.Errorf("expect round trip: %v, but got: %v", tags, back)
+	}
+
+	ports := NewInt(443, 80)
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	var usage strings.Builder
+	fs.SetOutput(&usage)
+	fs.Var(Flag(&ports), "port", "comma separated ports")
+	fs.PrintDefaults()
+	if !strings.Contains(usage.String(), "(default 80,443)") {
+		t.Errorf("expect default: 80,443, but got: %s", usage.String())
+	}
+	if err := fs.Parse([]string{"-port", "8080"}); err != nil {
+		t.Fatalf("expect no error, but got: %v", err)
+	}
+	validateSet(t, ports, []int{80, 443, 8080})
+
+	var empty Float64
+	if got := Flag(&empty).String(); got != "" {
+		t.Errorf("expect empty flag string, but got: %s", got)
+	}
+}
+
+func TestSet_UnmarshalText(t *testing.T) {
+	testcases := []struct {
+		name   string
+		text   string
+		expect []string
+		err    string
+	}{
+		{name: "empty", text: "", expect: []string{}},
+		{name: "blank", text: "  ", expect: []string{}},
+		{name: "plain", text: "a,b,c", expect: []string{"a", "b", "c"}},
+		{name: "spaces", text: " a , b c ,c", expect: []string{"a", "b c", "c"}},
+		{name: "duplicates", text: "a,a,b", expect: []string{"a", "b"}},
+		{name: "quoted", text: `"a,b", "", "\"c\"", " d "`, expect: []string{"a,b", "", `"c"`, " d "}},
+		{name: "escapes", text: `"\té"`, expect: []string{"\té"}},
+		{name: "empty element", text: "a,,b", err: "empty element at byte 2"},
+		{name: "trailing comma", text: "a,b,", err: "empty element at byte 4"},
+		{name: "unterminated", text: `a,"b`, err: `parse "\"b" at byte 2: unterminated quote`},
+		{name: "bad escape", text: `"\q"`, err: "byte 0: invalid syntax"},
+		{name: "garbage after quote", text: `"a"b,c`, err: `parse "b,c" at byte 3: expect a comma`},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		s := NewString("stale")
+		err := s.UnmarshalText([]byte(tc.text))
+		if tc.err != "" {
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Errorf("expect error containing: %s, but got: %v", tc.err, err)
+			}
+			validateSet(t, s, []string{"stale"})
+			continue
+		}
+		if err != nil {
+			t.Fatalf("expect no error, but got: %v", err)
+		}
+		validateSet(t, s, tc.expect)
+	}
+}
+
+func TestSet_UnmarshalTextNumbers(t *testing.T) {
+	var i8 Int8
+	if err := i8.UnmarshalText([]byte("1, -128,127")); err != nil {
+		t.Fatalf("expect no error, but got: %v", err)
+	}
+	validateSet(t, i8, []int8{1, -128, 127})
+	err := i8.UnmarshalText([]byte("1,128"))
+	if err == nil || !strings.Contains(err.Error(), `parse "128" at byte 2: strconv.ParseInt`) {
+		t.Errorf("expect out of range error, but got: %v", err)
+	}
+
+	var u Uint
+	err = u.UnmarshalText([]byte("1,-1"))
+	if err == nil || !strings.Contains(err.Error(), `parse "-1" at byte 2`) {
+		t.Errorf("expect invalid syntax error, but got: %v", err)
+	}
+
+	var f Float64
+	if err := f.UnmarshalText([]byte("1.5,-Infinity,1e3")); err != nil {
+		t.Fatalf("expect no error, but got: %v", err)
+	}
+	validateSet(t, f, []float64{1.5, math.Inf(-1), 1000})
+
+	var b Set[bool]
+	if err := b.UnmarshalText([]byte("true,false,true")); err != nil {
+		t.Fatalf("expect no error, but got: %v", err)
+	}
+	validateSet(t, b, []bool{true, false})
+
+	var p Set[formatPoint]
+	err = p.UnmarshalText([]byte("a"))
+	if err == nil || !strings.Contains(err.Error(), "cannot parse set.formatPoint elements") {
+		t.Errorf("expect unsupported type error, but got: %v", err)
+	}
+}
+
+func TestSet_MarshalText(t *testing.T) {
+	testcases := []struct {
+		name   string
+		set    interface{ MarshalText() ([]byte, error) }
+		expect string
+	}{
+		{name: "nil", set: String(nil), expect: ""},
+		{name: "strings", set: NewString("c", "a", "b"), expect: "a,b,c"},
+		{name: "quoted strings", set: NewString("", "a,b", `"`, " c", "d\n"), expect: `""," c","\"","a,b","d\n"`},
+		{name: "ints", set: NewInt(10, -1, 2), expect: "-1,2,10"},
+		{name: "floats", set: NewFloat64(math.Inf(1), 0.5, -2), expect: "-2,0.5,Infinity"},
+		{name: "bools", set: New(true, false), expect: "false,true"},
+		{name: "named ints", set: New[formatID](2, 1), expect: "1,2"},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		text, err := tc.set.MarshalText()
+		if err != nil {
+			t.Fatalf("expect no error, but got: %v", err)
+		}
+		if string(text) != tc.expect {
+			t.Errorf("expect text: %s, but got: %s", tc.expect, text)
+		}
+	}
+
+	if _, err := New[formatPoint](formatPoint{}).MarshalText(); err == nil {
+		t.Errorf("expect error for struct elements, but got: %v", err)
+	}
+}
+
+func TestSet_TextRoundTrip(t *testing.T) {
+	s := NewString("", "a", "b,c", `"q"`, " padded ", "tab\t", "é", "\xff")
+	text, err := s.MarshalText()
+	if err != nil {
+		t.Fatalf("expect no error, but got: %v", err)
+	}
+	var u String
+	if err := u.UnmarshalText(text); err != nil {
+		t.Fatalf("expect no error, but got: %v", err)
+	}
+	if !s.Equal(u) {
+		t.Errorf("expect set: %v, but got: %v", s, u)
+	}
+}
@@ -0,0 +1,232 @@
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package set
+
+// The named sets below are kept so that code written against the concrete
+// per-type sets keeps compiling: each one is an alias of Set instantiated
+// with its element type, and shares the full method set of Set.
+
+// Interface is an interface{} collection that contains no duplicate elements, without any particular order.
+type Interface = Set[interface{}]
+
+// NewInterface initializes a new Interface.
+func NewInterface(elements ...interface{}) Interface {
+	return New(elements...)
+}
+
+// NewInterfaceWithSize initializes a new Interface with the specified size.
+func NewInterfaceWithSize(size int) Interface {
+	return NewWithSize[interface{}](size)
+}
+
+// String is a string collection that contains no duplicate elements, without any particular order.
+type String = Set[string]
+
+// NewString initializes a new String.
+func NewString(elements ...string) String {
+	return New(elements...)
+}
+
+// NewStringWithSize initializes a new String with the specified size.
+func NewStringWithSize(size int) String {
+	return NewWithSize[string](size)
+}
+
+// Int is an int collection that contains no duplicate elements, without any particular order.
+type Int = Set[int]
+
+// NewInt initializes a new Int.
+func NewInt(elements ...int) Int {
+	return New(elements...)
+}
+
+// NewIntWithSize initializes a new Int with the specified size.
+func NewIntWithSize(size int) Int {
+	return NewWithSize[int](size)
+}
+
+// Int8 is an int8 collection that contains no duplicate elements, without any particular order.
+type Int8 = Set[int8]
+
+// NewInt8 initializes a new Int8.
+func NewInt8(elements ...int8) Int8 {
+	return New(elements...)
+}
+
+// NewInt8WithSize initializes a new Int8 with the specified size.
+func NewInt8WithSize(size int) Int8 {
+	return NewWithSize[int8](size)
+}
+
+// Int16 is an int16 collection that contains no duplicate elements, without any particular order.
+type Int16 = Set[int16]
+
+// NewInt16 initializes a new Int16.
+func NewInt16(elements ...int16) Int16 {
+	return New(elements...)
+}
+
+// NewInt16WithSize initializes a new Int16 with the specified size.
+func NewInt16WithSize(size int) Int16 {
+	return NewWithSize[int16](size)
+}
+
+// Int32 is an int32 collection that contains no duplicate elements, without any particular order.
+type Int32 = Set[int32]
+
+// NewInt32 initializes a new Int32.
+func NewInt32(elements ...int32) Int32 {
+	return New(elements...)
+}
+
+// NewInt32WithSize initializes a new Int32 with the specified size.
+func NewInt32WithSize(size int) Int32 {
+	return NewWithSize[int32](size)
+}
+
+// Int64 is an int64 collection that contains no duplicate elements, without any particular order.
+type Int64 = Set[int64]
+
+// NewInt64 initializes a new Int64.
+func NewInt64(elements ...int64) Int64 {
+	return New(elements...)
+}
+
+// NewInt64WithSize initializes a new Int64 with the specified size.
+func NewInt64WithSize(size int) Int64 {
+	return NewWithSize[int64](size)
+}
+
+// Float32 is a float32 collection that contains no duplicate elements, without any particular order.
+// As map keys, NaN is never equal to itself, so every NaN added is a new
+// element which Has and Remove cannot find, and -0 and +0 are the same
+// element. See FloatSet for a single NaN member and ApproxSet for a
+// tolerance.
+type Float32 = Set[float32]
+
+// NewFloat32 initializes a new Float32.
+func NewFloat32(elements ...float32) Float32 {
+	return New(elements...)
+}
+
+// NewFloat32WithSize initializes a new Float32 with the specified size.
+func NewFloat32WithSize(size int) Float32 {
+	return NewWithSize[float32](size)
+}
+
+// Float64 is a float64 collection that contains no duplicate elements, without any particular order.
+// As map keys, NaN is never equal to itself, so every NaN added is a new
+// element which Has and Remove cannot find, and -0 and +0 are the same
+// element. See FloatSet for a single NaN member and ApproxSet for a
+// tolerance.
+type Float64 = Set[float64]
+
+// NewFloat64 initializes a new Float64.
+func NewFloat64(elements ...float64) Float64 {
+	return New(elements...)
+}
+
+// NewFloat64WithSize initializes a new Float64 with the specified size.
+func NewFloat64WithSize(size int) Float64 {
+	return NewWithSize[float64](size)
+}
+
+// Uint is an uint collection that contains no duplicate elements, without any particular order.
+type Uint = Set[uint]
+
+// NewUint initializes a new Uint.
+func NewUint(elements ...uint) Uint {
+	return New(elements...)
+}
+
+// NewUintWithSize initializes a new Uint with the specified size.
+func NewUintWithSize(size int) Uint {
+	return NewWithSize[uint](size)
+}
+
+// Uint8 is an uint8 collection that contains no duplicate elements, without any particular order.
+type Uint8 = Set[uint8]
+
+// NewUint8 initializes a new Uint8.
+func NewUint8(elements ...uint8) Uint8 {
+	return New(elements...)
+}
+
+// NewUint8WithSize initializes a new Uint8 with the specified size.
+func NewUint8WithSize(size int) Uint8 {
+	return NewWithSize[uint8](size)
+}
+
+// Uint16 is an uint16 collection that contains no duplicate elements, without any particular order.
+type Uint16 = Set[uint16]
+
+// NewUint16 initializes a new Uint16.
+func NewUint16(elements ...uint16) Uint16 {
+	return New(elements...)
+}
+
+// NewUint16WithSize initializes a new Uint16 with the specified size.
+func NewUint16WithSize(size int) Uint16 {
+	return NewWithSize[uint16](size)
+}
+
+// Uint32 is an uint32 collection that contains no duplicate elements, without any particular order.
+type Uint32 = Set[uint32]
+
+// NewUint32 initializes a new Uint32.
+func NewUint32(elements ...uint32) Uint32 {
+	return New(elements...)
+}
+
+// NewUint32WithSize initializes a new Uint32 with the specified size.
+func NewUint32WithSize(size int) Uint32 {
+	return NewWithSize[uint32](size)
+}
+
+// Uint64 is an uint64 collection that contains no duplicate elements, without any particular order.
+type Uint64 = Set[uint64]
+
+// NewUint64 initializes a new Uint64.
+func NewUint64(elements ...uint64) Uint64 {
+	return New(elements...)
+}
+
+// NewUint64WithSize initializes a new Uint64 with the specified size.
+func NewUint64WithSize(size int) Uint64 {
+	return NewWithSize[uint64](size)
+}
+
+// Uintptr is an uintptr collection that contains no duplicate elements, without any particular order.
+type Uintptr = Set[uintptr]
+
+// NewUintptr initializes a new Uintptr.
+func NewUintptr(elements ...uintptr) Uintptr {
+	return New(elements...)
+}
+
+// NewUintptrWithSize initializes a new Uintptr with the specified size.
+func NewUintptrWithSize(size int) Uintptr {
+	return NewWithSize[uintptr](size)
+}
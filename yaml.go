@@ -0,0 +1,108 @@
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package set
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// The YAML methods of the sets follow the interfaces of the common YAML
+// libraries without importing one:
+//
+//	type Marshaler interface {
+//		MarshalYAML() (interface{}, error)
+//	}
+//
+//	type Unmarshaler interface {
+//		UnmarshalYAML(unmarshal func(interface{}) error) error
+//	}
+//
+// gopkg.in/yaml.v2 uses both, gopkg.in/yaml.v3 still calls this form of
+// UnmarshalYAML, and sigs.k8s.io/yaml converts to JSON and uses the JSON
+// methods instead.
+
+// MarshalYAML implements yaml.Marshaler, it encodes the Set as a sequence
+// sorted in the natural order of the elements. A nil Set encodes as null.
+func (s Set[T]) MarshalYAML() (interface{}, error) {
+	if s == nil {
+		return nil, nil
+	}
+	v := s.List()
+	sortElements(v)
+	return yamlElements(v), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, it replaces the Set with the
+// elements of a sequence, merging duplicate elements.
+//
+// Interface sets reject the elements which are not hashable, such as nested
+// sequences and mappings, with an error rather than panicking when they are
+// added to the Set.
+func (s *Set[T]) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	v, err := unmarshalYAMLElements[T](unmarshal)
+	if err != nil {
+		return err
+	}
+	if v == nil {
+		*s = nil
+		return nil
+	}
+	u := NewWithSize[T](len(v))
+	u.Add(v...)
+	*s = u
+	return nil
+}
+
+// yamlElements returns the value which a YAML library encodes as a sequence
+// of the elements.
+func yamlElements[T any](v []T) interface{} {
+	if v == nil {
+		v = []T{}
+	}
+	if reflect.TypeOf(v).Elem().Kind() == reflect.Uint8 {
+		// byte slices may be encoded as binary, box the elements so that
+		// each one encodes as a number
+		boxed := make([]interface{}, len(v))
+		for i, element := range v {
+			boxed[i] = element
+		}
+		return boxed
+	}
+	return v
+}
+
+// unmarshalYAMLElements returns the elements of the sequence decoded by
+// unmarshal, it returns an error if an element is not hashable.
+func unmarshalYAMLElements[T comparable](unmarshal func(interface{}) error) ([]T, error) {
+	var v []T
+	if err := unmarshal(&v); err != nil {
+		return nil, err
+	}
+	if i, ok := unhashableElement(v); ok {
+		return nil, fmt.Errorf("set: YAML sequence element %d of type %T is not hashable", i, v[i])
+	}
+	return v, nil
+}
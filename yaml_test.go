@@ -0,0 +1,283 @@
+/*
+MIT License
+
+Copyright (c) 2021 Seanan Xu
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package set
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+type yamlMarshaler interface {
+	MarshalYAML() (interface{}, error)
+}
+
+type yamlUnmarshaler interface {
+	UnmarshalYAML(unmarshal func(interface{}) error) error
+}
+
+var (
+	_ yamlMarshaler   = String(nil)
+	_ yamlUnmarshaler = (*String)(nil)
+	_ yamlMarshaler   = (*Sync[int])(nil)
+	_ yamlUnmarshaler = (*Sync[int])(nil)
+	_ yamlMarshaler   = (*Sharded[int])(nil)
+	_ yamlUnmarshaler = (*Sharded[int])(nil)
+	_ yamlMarshaler   = (*Bitset[uint8])(nil)
+	_ yamlUnmarshaler = (*Bitset[uint8])(nil)
+	_ yamlMarshaler   = (*Roaring[uint32])(nil)
+	_ yamlUnmarshaler = (*Roaring[uint32])(nil)
+	_ yamlMarshaler   = (*OrderedSet[int])(nil)
+	_ yamlUnmarshaler = (*OrderedSet[int])(nil)
+	_ yamlMarshaler   = (*LinkedSet[int])(nil)
+	_ yamlUnmarshaler = (*LinkedSet[int])(nil)
+)
+
+// yamlDecode is a minimal stand-in for a YAML library, it parses a block
+// sequence of plain scalars, where an element may be a flow sequence, and
+// calls UnmarshalYAML as gopkg.in/yaml.v2 does.
+func yamlDecode(doc string, out yamlUnmarshaler) error {
+	var node interface{}
+	if doc = strings.TrimSpace(doc); doc != "" {
+		nodes := []interface{}{}
+		for _, line := range strings.Split(doc, "\n") {
+			line = strings.TrimSpace(line)
+			if !strings.HasPrefix(line, "- ") {
+				return fmt.Errorf("yaml: expect a sequence entry: %q", line)
+			}
+			nodes = append(nodes, yamlScalar(strings.TrimSpace(line[2:])))
+		}
+		node = nodes
+	}
+	return out.UnmarshalYAML(func(v interface{}) error {
+		return yamlAssign(reflect.ValueOf(v).Elem(), node)
+	})
+}
+
+// yamlScalar resolves a plain scalar to the types used by gopkg.in/yaml.v2.
+func yamlScalar(text string) interface{} {
+	if strings.HasPrefix(text, "[") && strings.HasSuffix(text, "]") {
+		nodes := []interface{}{}
+		for _, item := range strings.Split(text[1:len(text)-1], ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				nodes = append(nodes, yamlScalar(item))
+			}
+		}
+		return nodes
+	}
+	if text == "{}" {
+		return map[interface{}]interface{}{}
+	}
+	if text == "~" || text == "null" {
+		return nil
+	}
+	if b, err := strconv.ParseBool(text); err == nil && (text == "true" || text == "false") {
+		return b
+	}
+	if i, err := strconv.Atoi(text); err == nil {
+		return i
+	}
+	if f, err := strconv.ParseFloat(text, 64); err == nil {
+		return f
+	}
+	return strings.Trim(text, `"`)
+}
+
+// yamlAssign stores node in v, converting the scalars as a YAML library does.
+func yamlAssign(v reflect.Value, node interface{}) error {
+	if node == nil {
+		v.Set(reflect.Zero(v.Type()))
+		return nil
+	}
+	n := reflect.ValueOf(node)
+	fail := fmt.Errorf("yaml: cannot unmarshal %T %v into %s", node, node, v.Type())
+	switch v.Kind() {
+	case reflect.Interface:
+		v.Set(n)
+	case reflect.Slice:
+		if n.Kind() != reflect.Slice {
+			return fail
+		}
+		v.Set(reflect.MakeSlice(v.Type(), n.Len(), n.Len()))
+		for i := 0; i < n.Len(); i++ {
+			if err := yamlAssign(v.Index(i), n.Index(i).Interface()); err != nil {
+				return err
+			}
+		}
+	case reflect.String:
+		if n.Kind() != reflect.String {
+			return fail
+		}
+		v.SetString(n.String())
+	case reflect.Bool:
+		if n.Kind() != reflect.Bool {
+			return fail
+		}
+		v.SetBool(n.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if n.Kind() != reflect.Int || v.OverflowInt(n.Int()) {
+			return fail
+		}
+		v.SetInt(n.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if n.Kind() != reflect.Int || n.Int() < 0 || v.OverflowUint(uint64(n.Int())) {
+			return fail
+		}
+		v.SetUint(uint64(n.Int()))
+	case reflect.Float32, reflect.Float64:
+		switch n.Kind() {
+		case reflect.Int:
+			v.SetFloat(float64(n.Int()))
+		case reflect.Float64:
+			v.SetFloat(n.Float())
+		default:
+			return fail
+		}
+	default:
+		return fail
+	}
+	return nil
+}
+
+func TestSet_MarshalYAML(t *testing.T) {
+	testcases := []struct {
+		name   string
+		s      yamlMarshaler
+		expect interface{}
+	}{
+		{name: "nil", s: String(nil), expect: nil},
+		{name: "empty", s: NewString(), expect: []string{}},
+		{name: "sorted strings", s: NewString("b", "c", "a"), expect: []string{"a", "b", "c"}},
+		{name: "sorted ints", s: NewInt(3, -1, 10), expect: []int{-1, 3, 10}},
+		{name: "boxed bytes", s: NewUint8(2, 1), expect: []interface{}{uint8(1), uint8(2)}},
+		{name: "interfaces", s: NewInterface("a", 1), expect: []interface{}{1, "a"}},
+		{name: "sync", s: NewSync(2, 1), expect: []int{1, 2}},
+		{name: "sharded", s: NewSharded(2, 1), expect: []int{1, 2}},
+		{name: "bitset", s: NewBitset[uint8](5, 1), expect: []int{1, 5}},
+		{name: "roaring", s: NewRoaring[uint32](1<<20, 1), expect: []uint32{1, 1 << 20}},
+		{name: "roaring empty", s: NewRoaring[uint32](), expect: []uint32{}},
+		{name: "ordered", s: NewOrderedSet("b", "a"), expect: []string{"a", "b"}},
+		{name: "linked", s: NewLinkedSet("b", "a"), expect: []string{"b", "a"}},
+		{name: "linked bytes", s: NewLinkedSet[uint8](2, 1), expect: []interface{}{uint8(2), uint8(1)}},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		v, err := tc.s.MarshalYAML()
+		if err != nil {
+			t.Fatalf("expect no error, but got: %v", err)
+		}
+		if !reflect.DeepEqual(v, tc.expect) {
+			t.Errorf("expect sequence: %#v, but got: %#v", tc.expect, v)
+		}
+	}
+}
+
+func TestSet_UnmarshalYAML(t *testing.T) {
+	s := NewString("stale")
+	if err := yamlDecode("- b\n- a\n- b\n", &s); err != nil {
+		t.Fatalf("expect no error, but got: %v", err)
+	}
+	validateSet(t, s, []string{"a", "b"})
+
+	if err := yamlDecode("", &s); err != nil {
+		t.Fatalf("expect no error, but got: %v", err)
+	}
+	if s != nil {
+		t.Errorf("expect nil set for null, but got: %v", s)
+	}
+
+	i8 := NewInt8(1)
+	err := yamlDecode("- 1\n- 300\n", &i8)
+	if err == nil || !strings.Contains(err.Error(), "cannot unmarshal int 300 into int8") {
+		t.Errorf("expect overflow error, but got: %v", err)
+	}
+	validateSet(t, i8, []int8{1})
+
+	var i Interface
+	if err := yamlDecode("- a\n- 1\n- 1.5\n- true\n- ~\n", &i); err != nil {
+		t.Fatalf("expect no error, but got: %v", err)
+	}
+	validateSet(t, i, []interface{}{"a", 1, 1.5, true, nil})
+
+	for _, doc := range []string{"- a\n- [b, c]\n", "- {}\n"} {
+		i = NewInterface("stale")
+		err = yamlDecode(doc, &i)
+		if err == nil || !strings.Contains(err.Error(), "is not hashable") {
+			t.Errorf("expect unhashable element error, but got: %v", err)
+		}
+		validateSet(t, i, []interface{}{"stale"})
+	}
+}
+
+func TestSet_UnmarshalYAMLTypes(t *testing.T) {
+	doc := "- 3\n- 1\n- 3\n- 2\n"
+	testcases := []struct {
+		name string
+		s    interface {
+			yamlMarshaler
+			yamlUnmarshaler
+		}
+		expect interface{}
+	}{
+		{name: "sync", s: NewSync(9), expect: []int{1, 2, 3}},
+		{name: "sharded", s: NewSharded(9), expect: []int{1, 2, 3}},
+		{name: "zero sharded", s: new(Sharded[int]), expect: []int{1, 2, 3}},
+		{name: "bitset", s: NewBitset[int16](9), expect: []int{1, 2, 3}},
+		{name: "roaring", s: NewRoaring[uint64](9), expect: []uint64{1, 2, 3}},
+		{name: "ordered", s: NewOrderedSet[float64](9), expect: []float64{1, 2, 3}},
+		{name: "linked", s: NewLinkedSet(9), expect: []int{3, 1, 2}},
+	}
+	for _, tc := range testcases {
+		t.Logf("running scenario: %s", tc.name)
+		if err := yamlDecode(doc, tc.s); err != nil {
+			t.Fatalf("expect no error, but got: %v", err)
+		}
+		v, err := tc.s.MarshalYAML()
+		if err != nil {
+			t.Fatalf("expect no error, but got: %v", err)
+		}
+		if !reflect.DeepEqual(v, tc.expect) {
+			t.Errorf("expect sequence: %#v, but got: %#v", tc.expect, v)
+		}
+		if err := yamlDecode("- a\n", tc.s); err == nil {
+			t.Errorf("expect error for a string element, but got: %v", err)
+		}
+	}
+}
+
+func TestSharded_UnmarshalYAMLZeroValue(t *testing.T) {
+	var config struct {
+		Ports Sharded[int]
+	}
+	if err := yamlDecode("- 443\n- 80\n", &config.Ports); err != nil {
+		t.Fatalf("expect no error, but got: %v", err)
+	}
+	validateSet(t, config.Ports.Snapshot(), []int{80, 443})
+	if config.Ports.Size() != 2 {
+		t.Errorf("expect size: 2, but got: %d", config.Ports.Size())
+	}
+}